@@ -42,13 +42,13 @@ func printBoard(fen string) {
 		}
 		color.Unset()
 	}
-	fmt.Println("\n   A  B  C  D  E  F  G  H \n")
+	fmt.Print("\n   A  B  C  D  E  F  G  H \n\n")
 }
 
-func printMoveList(moves []*goengine.Move) {
+func printMoveList(game *goengine.Game, moves []goengine.Move) {
 	fmt.Printf("Moves: ")
 	for _, move := range moves {
-		fmt.Printf("%s, ", move.ToString())
+		fmt.Printf("%s, ", game.SAN(move))
 	}
 	fmt.Println()
 }
\ No newline at end of file
@@ -2,4 +2,7 @@ module github.com/hmccarty/gochess
 
 go 1.14
 
-require github.com/fatih/color v1.9.0
\ No newline at end of file
+require (
+	github.com/fatih/color v1.9.0
+	github.com/klauspost/compress v1.11.13
+)
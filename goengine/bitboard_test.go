@@ -0,0 +1,162 @@
+package goengine
+
+import "testing"
+
+// Classical ray attacks, kept as an oracle for the magic tables
+var rayAttacks [64][8]uint64
+
+type RayDir uint8
+const (
+	NORTH RayDir = iota
+	N_EAST
+	EAST
+	S_EAST
+	SOUTH
+	S_WEST
+	WEST
+	N_WEST
+)
+
+func initRayAttacks() {
+	// Calculate ray attacks
+	// TODO: Find a more elegant approach to ray-move calculation
+	for i, _ := range rayAttacks {
+		row := i / 8
+		col := i % 8  
+		
+		// Calculate north ray attacks
+		for j := 8; j > row; j-- {
+			rayAttacks[i][NORTH] = moveNorth((1 << i) | rayAttacks[i][NORTH])
+		}
+
+		// Calculate north-east ray attacks
+		for j := 8; j > row; j-- {
+			rayAttacks[i][N_EAST] = moveNEast((1 << i) | rayAttacks[i][N_EAST])
+		}
+
+		// Calculate east ray attacks
+		for j := 0; j < col; j++ {
+			rayAttacks[i][EAST] = moveEast((1 << i) | rayAttacks[i][EAST])
+		}
+
+		// Calculate south-east ray attacks
+		for j := row; j > 0; j-- {
+			rayAttacks[i][S_EAST] = moveSEast((1 << i) | rayAttacks[i][S_EAST])
+		}
+
+		// Calculate south ray attacks
+		for j := row; j > 0; j-- {
+			rayAttacks[i][SOUTH] = moveSouth((1 << i) | rayAttacks[i][SOUTH])
+		}
+
+		// Calculate south-west ray attacks
+		for j := row; j > 0; j-- {
+			rayAttacks[i][S_WEST] = moveSWest((1 << i) | rayAttacks[i][S_WEST])
+		}
+
+		// Calculate west ray attacks
+		for j := col; j < 8; j++ {
+			rayAttacks[i][WEST] = moveWest((1 << i) | rayAttacks[i][WEST])
+		}
+
+		// Calculate north-west ray attacks
+		for j := 8; j > row; j-- {
+			rayAttacks[i][N_WEST] = moveNWest((1 << i) | rayAttacks[i][N_WEST])
+		}
+	}
+}
+
+func rayTransSet(piece uint64, occup uint64) uint64 {
+	var set uint64 = 0
+	for piece != 0 {
+		var sqr uint8 = bitScanForward(piece)
+		set |= getPosRayAttacks(sqr, occup, NORTH)
+		set |= getNegRayAttacks(sqr, occup, EAST)
+		set |= getPosRayAttacks(sqr, occup, WEST)
+		set |= getNegRayAttacks(sqr, occup, SOUTH)
+		piece ^= (1 << sqr)
+	}
+	return set
+}
+
+func rayDiagSet(bb uint64, occup uint64) uint64 {
+	var set uint64 = 0
+	for bb != 0 {
+		var sqr uint8 = bitScanForward(bb)
+		set |= getPosRayAttacks(sqr, occup, N_EAST)
+		set |= getPosRayAttacks(sqr, occup, N_WEST)
+		set |= getNegRayAttacks(sqr, occup, S_EAST)
+		set |= getNegRayAttacks(sqr, occup, S_WEST)
+		bb ^= (1 << sqr)
+	}
+	return set
+}
+
+func getPosRayAttacks(sqr uint8, occup uint64, dir RayDir) uint64 {
+	var attacks uint64 = rayAttacks[sqr][dir]
+	var blockers uint64 = attacks & (^occup)
+	sqr = bitScanForward(blockers | (0x8000000000000000))
+	return attacks ^ rayAttacks[sqr][dir]
+}
+
+func getNegRayAttacks(sqr uint8, occup uint64, dir RayDir) uint64 {
+	var attacks uint64 = rayAttacks[sqr][dir]
+	var blockers uint64 = attacks & (^occup)
+	sqr = bitScanReverse(blockers | 1)
+	return attacks ^ rayAttacks[sqr][dir]
+}
+
+
+func TestMagicAttacks(t *testing.T) {
+	initRayAttacks()
+
+	var seed uint64 = ZOBRIST_SEED
+	for i := 0; i < 1000; i++ {
+		// Sparse and dense occupancies
+		var occupied uint64 = nextRandom(&seed) & nextRandom(&seed)
+		if i % 2 == 0 {
+			occupied |= nextRandom(&seed)
+		}
+
+		for sqr := uint8(0); sqr < 64; sqr++ {
+			var bb uint64 = 1 << sqr
+			if rookAttacks(sqr, occupied) != rayTransSet(bb, ^occupied) {
+				t.Fatalf("Rook attacks differ on %s with occupancy %x",
+						 sqrToString(sqr), occupied)
+			}
+			if bishopAttacks(sqr, occupied) != rayDiagSet(bb, ^occupied) {
+				t.Fatalf("Bishop attacks differ on %s with occupancy %x",
+						 sqrToString(sqr), occupied)
+			}
+		}
+	}
+}
+
+func benchmarkSliders(b *testing.B, rook func(uint64, uint64) uint64,
+					  bishop func(uint64, uint64) uint64, occupied uint64) {
+	for i := 0; i < b.N; i++ {
+		for sqr := uint8(0); sqr < 64; sqr++ {
+			rook(1 << sqr, occupied)
+			bishop(1 << sqr, occupied)
+		}
+	}
+}
+
+func BenchmarkRayAttacks(b *testing.B) {
+	initRayAttacks()
+	var game *Game = NewGame()
+	benchmarkSliders(b, rayTransSet, rayDiagSet, game.board.piece[EMPTY])
+}
+
+func BenchmarkMagicAttacks(b *testing.B) {
+	var game *Game = NewGame()
+	benchmarkSliders(b, getTransSet, getDiagSet, ^game.board.piece[EMPTY])
+}
+
+func BenchmarkPerft(b *testing.B) {
+	var game *Game
+	game, _ = FromFEN("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1")
+	for i := 0; i < b.N; i++ {
+		perft(game, 3)
+	}
+}
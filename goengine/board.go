@@ -1,9 +1,6 @@
 package goengine
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
 const NOT_A_FILE = 0x7f7f7f7f7f7f7f7f
 const NOT_H_FILE = 0xfefefefefefefefe
@@ -23,6 +20,7 @@ type Board struct {
 	color [2]uint64
 	castle [2]uint8
 	ep uint64
+	hash uint64
 }
 
 type GetSet func(uint64, Color) uint64
@@ -69,158 +67,143 @@ func (board *Board) setup() {
 	board.castle[WHITE] = (K_CASTLE_MASK | Q_CASTLE_MASK)
 	board.castle[BLACK] = (K_CASTLE_MASK | Q_CASTLE_MASK)
 
-	// Initialize array to track piece rays
-	initRayAttacks()
+	board.hash = board.computeHash(WHITE)
 }
 
-func (board *Board) processMove(move *Move) error {
-	move.target = board.findPiece(move.to)
-
-	if (move.piece == EMPTY) {
-		return errors.New("Piece does not exist at square.")
-	} else if (move.target == EMPTY) {
-		move.target = move.piece
+// Makes or takes back a move, as every board change is an XOR
+func (board *Board) applyMove(move Move) {
+	switch move.Flag() {
+	case QUIET:
+		board.quietMove(move)
+	case CAPTURE:
+		board.capture(move)
+	case K_CASTLE:
+		board.castleKingSide(move)
+	case Q_CASTLE:
+		board.castleQueenSide(move)
+	case PROMOTION:
+		board.promote(move)
+	case EP_CAPTURE:
+		board.epCapture(move)
 	}
+}
 
-	switch move.piece {
-	case KING:
-		if ((move.flag != K_CASTLE) && (move.flag != Q_CASTLE)) &&
-		   ((move.to & board.getKingSet(move.from, move.color)) == 0) {
-			if (move.to & (board.piece[KING] >> 2) != 0) {
-				move.flag = K_CASTLE
-			} else if (move.to & (board.piece[KING] << 2) != 0) {
-				move.flag = Q_CASTLE	
-			} else {
-				return errors.New("Invalid king move.")
-			}
-		}
-	case QUEEN:
-		if ((move.to & board.getQueenSet(move.from, move.color)) == 0) {
-			return errors.New("Invalid queen move.")
-		}
-	case ROOK:
-		if ((move.to & board.getRookSet(move.from, move.color)) == 0) {
-			return errors.New("Invalid rook move.")
-		}
-	case BISHOP:
-		if ((move.to & board.getBishopSet(move.from, move.color)) == 0) {
-			return errors.New("Invalid bishop move.")
-		}
-	case KNIGHT:
-		if ((move.to & board.getKnightSet(move.from, move.color)) == 0) {
-			return errors.New("Invalid knight move.")
-		}
-	case PAWN:
-		if ((move.to & board.getPawnSet(move.from, move.color)) == 0) {
-			fmt.Println(move.color)
-			return errors.New("Invalid pawn move.")
-		} else if (move.to & EIGTH_RANK) != 0 {
-			move.flag = PROMOTION
-		} else if ((move.to & board.ep) != 0) &&
-				  ((move.to & board.piece[PAWN]) == 0) {
-			move.flag = EP_CAPTURE
-			move.points = pieceToPoints[PAWN]
-		} else {
-			if move.color == WHITE {
-				if (moveNorth(moveNorth(move.from)) & move.to) != 0 {
-					move.ep = move.to | moveSouth(move.to)
-				}
-			} else {
-				if (moveSouth(moveSouth(move.from)) & move.to) != 0 {
-					move.ep = move.to | moveNorth(move.to)
-				}
-			}
-		}
-		move.halfmove = 0
-	case EMPTY:
-		return errors.New("Piece doesn't exist at square.")
-	}
+func (board *Board) quietMove(move Move) {
+	var from uint64 = move.fromBB()
+	var to uint64 = move.toBB()
+	var color Color = move.Color()
+	board.piece[move.Piece()] ^= (from ^ to)
+	board.color[color] ^= (from ^ to)
+	board.hash ^= pieceKey(color, move.Piece(), from) ^
+				  pieceKey(color, move.Piece(), to)
 
-	if move.flag == UNKNOWN {
-		if (move.to & board.piece[EMPTY]) == 0 {
-			move.flag = CAPTURE
-			move.points = pieceToPoints[move.target]
-			move.halfmove = 0
-		} else {
-			move.flag = QUIET
-		}
-	} else if move.flag == K_CASTLE {
-		if !board.canCastleKingSide(move.color) {
-			return errors.New("Cannot castle king side.")
-		}
-	} else if move.flag == Q_CASTLE {
-		if !board.canCastleQueenSide(move.color) {
-			return errors.New("Cannot castle queen side.")
-		}
-	}
+	board.piece[EMPTY] = board.findEmptySpaces()
+}
 
-	move.castle[WHITE] = board.castle[WHITE]
-	move.castle[BLACK] = board.castle[BLACK]
-	if (move.piece == KING) {
-		move.castle[move.color] = 0
-	} else if (move.piece == ROOK) {
-		if ((move.from & A_FILE_CORNERS) != 0) {
-			move.castle[move.color] &= (^Q_CASTLE_MASK)
-		} else if ((move.from & H_FILE_CORNERS) != 0) {
-			move.castle[move.color] &= (^K_CASTLE_MASK)
-		}
-	}
+func (board *Board) capture(move Move) {
+	var from uint64 = move.fromBB()
+	var to uint64 = move.toBB()
+	var color Color = move.Color()
 
-	return nil
-}
+	// Remove attacked piece
+	board.piece[move.Captured()] ^= to
+	board.color[oppColor[color]] ^= to
+	board.hash ^= pieceKey(oppColor[color], move.Captured(), to)
 
-func (board *Board) quietMove(move *Move) {
-	board.piece[move.piece] ^= move.from
-	board.piece[move.target] ^= move.to
-	board.color[move.color] ^= (move.from ^ move.to)
+	// Move piece on attacking board
+	board.piece[move.Piece()] ^= (from ^ to)
+	board.color[color] ^= (from ^ to)
+	board.hash ^= pieceKey(color, move.Piece(), from) ^
+				  pieceKey(color, move.Piece(), to)
 
 	board.piece[EMPTY] = board.findEmptySpaces()
 }
 
-func (board *Board) capture(move *Move) {
-	// Remove attacked piece
-	board.piece[move.target] ^= move.to
-	board.color[oppColor[move.color]] ^= move.to
+func (board *Board) epCapture(move Move) {
+	var from uint64 = move.fromBB()
+	var to uint64 = move.toBB()
+	var color Color = move.Color()
 
-	// Move piece on attacking board
-	board.piece[move.piece] ^= (move.from ^ move.to)
-	board.color[move.color] ^= (move.from ^ move.to)
+	// Captured pawn sits behind the target square
+	var captured uint64
+	if (color == WHITE) {
+		captured = moveSouth(to)
+	} else {
+		captured = moveNorth(to)
+	}
 
+	board.piece[PAWN] ^= from | to | captured
+	board.color[color] ^= (from ^ to)
+	board.color[oppColor[color]] ^= captured
+	board.hash ^= pieceKey(color, PAWN, from) ^
+				  pieceKey(color, PAWN, to) ^
+				  pieceKey(oppColor[color], PAWN, captured)
 	board.piece[EMPTY] = board.findEmptySpaces()
 }
 
-func (board *Board) epCapture(move *Move) {
-	board.piece[PAWN] ^= board.ep | move.from
-	board.color[move.color] ^= (move.from ^ move.to)
-	board.color[oppColor[move.color]] ^= (board.ep ^ move.to)
+func (board *Board) promote(move Move) {
+	var from uint64 = move.fromBB()
+	var to uint64 = move.toBB()
+	var color Color = move.Color()
+
+	// Remove captured piece, if any
+	if (move.Captured() != EMPTY) {
+		board.piece[move.Captured()] ^= to
+		board.color[oppColor[color]] ^= to
+		board.hash ^= pieceKey(oppColor[color], move.Captured(), to)
+	}
+
+	// Swap pawn for promoted piece
+	board.piece[PAWN] ^= from
+	board.piece[move.Promotion()] ^= to
+	board.color[color] ^= (from ^ to)
+	board.hash ^= pieceKey(color, PAWN, from) ^
+				  pieceKey(color, move.Promotion(), to)
+
 	board.piece[EMPTY] = board.findEmptySpaces()
 }
 
-func (board *Board) castleKingSide(move *Move) {
-	if (move.color == WHITE) {
+func (board *Board) castleKingSide(move Move) {
+	var color Color = move.Color()
+	if (color == WHITE) {
 		board.piece[KING] ^= 0x0A
 		board.piece[ROOK] ^= 0x05
-		board.color[move.color] ^= 0x0F
+		board.color[color] ^= 0x0F
 	} else {
 		board.piece[KING] ^= (0x0A << 56)
 		board.piece[ROOK] ^= (0x05 << 56)
-		board.color[move.color] ^= 0x0F << 56
+		board.color[color] ^= 0x0F << 56
 	}
 
+	// King moves e -> g, rook moves h -> f
+	var rank uint8 = 56 * uint8(color)
+	board.hash ^= pieceKeys[color][KING][rank + 3] ^
+				  pieceKeys[color][KING][rank + 1] ^
+				  pieceKeys[color][ROOK][rank] ^
+				  pieceKeys[color][ROOK][rank + 2]
+
 	board.piece[EMPTY] = board.findEmptySpaces()
 }
 
-func (board *Board) castleQueenSide(move *Move) {
-	if (move.color == WHITE) {
+func (board *Board) castleQueenSide(move Move) {
+	var color Color = move.Color()
+	if (color == WHITE) {
 		board.piece[KING] ^= 0x28
 		board.piece[ROOK] ^= 0x90
-		board.color[move.color] ^= 0xB8
+		board.color[color] ^= 0xB8
 	} else {
 		board.piece[KING] ^= (0x28 << 56)
 		board.piece[ROOK] ^= (0x90 << 56)
-		board.color[move.color] ^= 0xB8 << 56
+		board.color[color] ^= 0xB8 << 56
 	}
 
+	// King moves e -> c, rook moves a -> d
+	var rank uint8 = 56 * uint8(color)
+	board.hash ^= pieceKeys[color][KING][rank + 3] ^
+				  pieceKeys[color][KING][rank + 5] ^
+				  pieceKeys[color][ROOK][rank + 7] ^
+				  pieceKeys[color][ROOK][rank + 4]
+
 	board.piece[EMPTY] = board.findEmptySpaces()
 }
 
@@ -245,83 +228,6 @@ func (board *Board) findColor(bb uint64) Color {
 	}
 }
 
-func (board *Board) clear() {
-	board.piece[KING] = 0
-	board.piece[QUEEN] = 0
-	board.piece[ROOK] = 0
-	board.piece[BISHOP] = 0
-	board.piece[KNIGHT] = 0
-	board.piece[PAWN] = 0
-	board.color[WHITE] = 0
-	board.color[BLACK] = 0
-}
-
-func (board *Board) setFENBoard(fen string) {
-	board.clear()
-
-	i := 63
-	for len(fen) > 0 {
-		piece := fen[0]
-		fen = fen[1:]
-		switch piece {
-		case 'K':
-			board.piece[KING] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'k':
-			board.piece[KING] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		case 'Q':
-			board.piece[QUEEN] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'q':
-			board.piece[QUEEN] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		case 'R':
-			board.piece[ROOK] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'r':
-			board.piece[ROOK] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		case 'B':
-			board.piece[BISHOP] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'b':
-			board.piece[BISHOP] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		case 'N':
-			board.piece[KNIGHT] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'n':
-			board.piece[KNIGHT] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		case 'P':
-			board.piece[PAWN] |= 1 << i
-			board.color[WHITE] |= 1 << i
-			i -= 1
-		case 'p':
-			board.piece[PAWN] |= 1 << i
-			board.color[BLACK] |= 1 << i
-			i -= 1
-		default:
-			if (piece >= byte('1')) && (piece <= byte('8')) {
-				i -= (int(piece) - (ASCII_ROW_OFFSET - 1))
-			}
-		}
-	}
-
-	board.piece[EMPTY] = board.findEmptySpaces()
-}
-
 func (board *Board) getFENBoard() string {
 	var mailbox [8][8]uint8 = board.getMailbox()
 	var fen string = ""
@@ -388,10 +294,17 @@ func (board *Board) canCastleKingSide(color Color) bool {
 		return false
 	}
 
-	var castle uint64 = uint64(K_CASTLE_MASK) << (56 * color)
+	// Rook must still be on its corner
+	var rank uint8 = 56 * uint8(color)
+	if (board.getBB(ROOK, color) & (0x01 << rank)) == 0 {
+		return false
+	}
+
+	var castle uint64 = uint64(K_CASTLE_MASK) << rank
 	if (board.piece[EMPTY] & castle) != castle {
 		return false
-	} else if (board.isSqrUnderAttack(bitScanForward(castle), color) ||
+	} else if (board.isKingInCheck(color) ||
+			   board.isSqrUnderAttack(bitScanForward(castle), color) ||
 			   board.isSqrUnderAttack(bitScanReverse(castle), color)) {
 		return false
 	} else {
@@ -404,10 +317,19 @@ func (board *Board) canCastleQueenSide(color Color) bool {
 		return false
 	}
 
-	var castle uint64 = uint64(Q_CASTLE_MASK) << (56 * color)
-	if ((board.piece[EMPTY] & castle) != castle) {
+	// Rook must still be on its corner
+	var rank uint8 = 56 * uint8(color)
+	if (board.getBB(ROOK, color) & (0x80 << rank)) == 0 {
 		return false
-	} else if (board.isSqrUnderAttack(bitScanForward(castle), color) ||
+	}
+
+	// Rook also passes over the b-file, which may be attacked
+	var castle uint64 = uint64(Q_CASTLE_MASK) << rank
+	var path uint64 = castle | (0x40 << rank)
+	if ((board.piece[EMPTY] & path) != path) {
+		return false
+	} else if (board.isKingInCheck(color) ||
+			   board.isSqrUnderAttack(bitScanForward(castle), color) ||
 			   board.isSqrUnderAttack(bitScanReverse(castle), color)) {
 		return false
 	} else {
@@ -430,6 +352,11 @@ func (board *Board) isSqrUnderAttack(sqr uint8, color Color) bool {
 	return (attacks != 0)
 }
 
+// Returns true if color has any piece besides its king and pawns
+func (board *Board) hasPieces(color Color) bool {
+	return (board.color[color] & ^(board.piece[KING] | board.piece[PAWN])) != 0
+}
+
 func (board *Board) getBB(piece Piece, color Color) uint64 {
 	return board.piece[piece] & board.color[color]
 }
@@ -470,16 +397,16 @@ func (board *Board) getKnightSet(bb uint64, color Color) uint64 {
 }
 
 func (board *Board) getRookSet(bb uint64, color Color) uint64 {
-	return getTransSet(bb, board.piece[EMPTY]) & (^board.color[color])
+	return getTransSet(bb, ^board.piece[EMPTY]) & (^board.color[color])
 }
 
 func (board *Board) getBishopSet(bb uint64, color Color) uint64 {
-	return getDiagSet(bb, board.piece[EMPTY]) & (^board.color[color])
+	return getDiagSet(bb, ^board.piece[EMPTY]) & (^board.color[color])
 }
 
 func (board *Board) getQueenSet(bb uint64, color Color) uint64 {
-	var moves uint64 = getTransSet(bb, board.piece[EMPTY]) |
-					   getDiagSet(bb, board.piece[EMPTY])
+	var moves uint64 = getTransSet(bb, ^board.piece[EMPTY]) |
+					   getDiagSet(bb, ^board.piece[EMPTY])
 	return moves & (^board.color[color])
 }
 
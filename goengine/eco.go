@@ -0,0 +1,96 @@
+package goengine
+
+import (
+	"strings"
+	"sync"
+)
+
+// An opening from the ECO table
+type Opening struct {
+	ECO string
+	Name string
+	// Moves in SAN that define the opening, e.g. "1. e4 e5 2. Nf3"
+	Moves string
+}
+
+// Openings keyed by the position their moves reach, built on first use
+var ecoOnce sync.Once
+var ecoPositions map[uint64]*Opening
+var ecoMaxPly int
+
+func loadECO() {
+	ecoPositions = make(map[uint64]*Opening)
+	for _, line := range strings.Split(ECO_DATA, "\n") {
+		var fields []string = strings.Split(line, "\t")
+		if len(fields) != 3 {
+			continue
+		}
+
+		var game *Game = NewGame()
+		var ply int = 0
+		var err error
+		for _, san := range strings.Fields(fields[2]) {
+			if isMoveNumber(strings.TrimRight(san, ".")) {
+				continue
+			}
+			err = game.pushMove(san)
+			if err != nil {
+				break
+			}
+			ply++
+		}
+
+		// The first name given to a position is kept
+		if _, ok := ecoPositions[game.Hash()]; (err == nil) && !ok {
+			ecoPositions[game.Hash()] = &Opening{
+				ECO   : fields[0],
+				Name  : fields[1],
+				Moves : fields[2],
+			}
+			if ply > ecoMaxPly {
+				ecoMaxPly = ply
+			}
+		}
+	}
+}
+
+// Returns the opening whose moves reach exactly this position, if any
+func LookupOpening(game *Game) (Opening, bool) {
+	ecoOnce.Do(loadECO)
+	if opening, ok := ecoPositions[game.Hash()]; ok {
+		return *opening, true
+	}
+	return Opening{}, false
+}
+
+// Returns the deepest opening of the ECO table the game passed through.
+// Positions are matched rather than moves, so transpositions into an
+// opening are recognised.
+func (game *Game) Opening() (Opening, bool) {
+	start, err := FromFEN(game.initFEN)
+	if err != nil {
+		return Opening{}, false
+	}
+	return classifyOpening(start, game.moves)
+}
+
+// Returns the deepest opening of the ECO table the main line passed
+// through
+func (pgn *PGNGame) Opening() (Opening, bool) {
+	start, err := pgn.StartingGame()
+	if err != nil {
+		return Opening{}, false
+	}
+	return classifyOpening(start, pgn.MainLine())
+}
+
+func classifyOpening(game *Game, moves []Move) (Opening, bool) {
+	opening, found := LookupOpening(game)
+	for i := 0; (i < len(moves)) && (i < ecoMaxPly); i++ {
+		game.makeMove(moves[i])
+		if deeper, ok := LookupOpening(game); ok {
+			opening, found = deeper, true
+		}
+	}
+	return opening, found
+}
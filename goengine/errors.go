@@ -0,0 +1,109 @@
+package goengine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned when a move cannot be played
+var (
+	ErrNoPiece = errors.New("Piece does not exist at square.")
+	ErrInvalidMove = errors.New("Piece cannot move to square.")
+	ErrCannotCastle = errors.New("Cannot castle.")
+	ErrOpponentPiece = errors.New("Cannot move opponent's piece.")
+	ErrKingInCheck = errors.New("King would be in check.")
+	ErrNoMatchingPiece = errors.New("Couldn't find piece to carry out move.")
+	ErrIllegalMove = errors.New("Illegal move.")
+	ErrNoMoves = errors.New("No moves to undo.")
+	ErrAmbiguousMove = errors.New("Move matches more than one piece.")
+	ErrNoDrawClaim = errors.New("No draw can be claimed.")
+)
+
+// Errors returned when parsing notation
+var (
+	ErrInvalidSquare = errors.New("Invalid square.")
+	ErrInvalidNotation = errors.New("Invalid move notation.")
+	ErrInvalidPromotion = errors.New("Invalid promotion piece.")
+	ErrCaptureMark = errors.New("Capture mark does not match move.")
+	ErrInvalidFEN = errors.New("Invalid FEN string.")
+	ErrInvalidEPD = errors.New("Invalid perft data in EPD file.")
+	ErrInvalidPackedBoard = errors.New("Invalid packed board.")
+)
+
+// Errors returned when parsing PGN
+var (
+	ErrInvalidPGN = errors.New("Invalid PGN.")
+	ErrUnexpectedCharacter = errors.New("Unexpected character.")
+	ErrUnexpectedToken = errors.New("Unexpected token.")
+	ErrUnterminatedString = errors.New("Unterminated string.")
+	ErrUnterminatedComment = errors.New("Unterminated comment.")
+	ErrUnterminatedVariation = errors.New("Unterminated variation.")
+)
+
+// Errors returned when running perft
+var (
+	ErrInvalidPerftArgs = errors.New("Usage: perft <depth> [fen] | perft <file> [max depth].")
+	ErrPerftMismatch = errors.New("Perft count mismatch.")
+)
+
+// Describes which field of a FEN string could not be parsed, and why if
+// the field is well formed but the position is impossible. Matches
+// ErrInvalidFEN with errors.Is.
+type FENError struct {
+	Field string
+	Value string
+	Reason string
+}
+
+func (err *FENError) Error() string {
+	if err.Reason != "" {
+		return fmt.Sprintf("Invalid %s data in FEN string: %s.", err.Field, err.Reason)
+	} else if err.Value == "" {
+		return fmt.Sprintf("Missing %s data in FEN string.", err.Field)
+	}
+	return fmt.Sprintf("Invalid %s data in FEN string: %q.", err.Field, err.Value)
+}
+
+func (err *FENError) Is(target error) bool {
+	return target == ErrInvalidFEN
+}
+
+// Describes a move that could not be parsed or played. Unwraps to one of
+// the move or notation errors above.
+type MoveError struct {
+	Notation string
+	Err error
+}
+
+func (err *MoveError) Error() string {
+	return fmt.Sprintf("%s: %q.", strings.TrimSuffix(err.Err.Error(), "."),
+					   err.Notation)
+}
+
+func (err *MoveError) Unwrap() error {
+	return err.Err
+}
+
+// Locates an error in PGN text. Unwraps to the underlying error and
+// matches ErrInvalidPGN with errors.Is.
+type PGNError struct {
+	Line int
+	Column int
+	// Byte offset in the uncompressed stream
+	Offset int64
+	Err error
+}
+
+func (err *PGNError) Error() string {
+	return fmt.Sprintf("Invalid PGN at line %d, column %d: %s", err.Line,
+					   err.Column, err.Err)
+}
+
+func (err *PGNError) Unwrap() error {
+	return err.Err
+}
+
+func (err *PGNError) Is(target error) bool {
+	return target == ErrInvalidPGN
+}
@@ -1,107 +1,133 @@
 package goengine
 
-import "fmt"
-
 const MAX_INT = int(^uint(0) >> 1)
 const MIN_INT = -MAX_INT - 1
 
-func minimax(game *Game, depth int, max bool,
-			alpha int, beta int) (int, *Move) {
-	if depth == 0 {
-		var move *Move = game.moves[len(game.moves) - 1]
-		return move.points, move
-	}
-
-	var best int 
-	var bestMove *Move
-	if max {
-		best = MIN_INT
-		var moves []*Move = game.getValidMoves()
-
-		for _, move := range moves {
-			game.makeMove(move)
-			var value int
-			value, _ = minimax(game, depth-1, false, alpha, beta)
-			value += move.points
-			game.undoMove()
-
-			if value > best {
-				best = value
-				bestMove = move
-			}
-
-			if best > alpha {
-				alpha = best
-			}
-
-			if beta <= alpha {
-				break
-			}
-		}
-		return best, bestMove
-	} else {
-		best = MAX_INT
-		var moves []*Move = game.getValidMoves()
-
-		for _, move := range moves {
-			game.makeMove(move)
-			var value int
-			value, _ = minimax(game, depth-1, true, alpha, beta)
-			value -= move.points
-			game.undoMove()
-
-			if value < best {
-				best = value
-				bestMove = move
-			}
-
-			if best < beta {
-				beta = best
-			}
+// Game phase when all minor and major pieces are on the board, where
+// knights and bishops count 1, rooks 2 and queens 4
+const MAX_PHASE = 24
+
+// Centipawn value of each piece, indexed by Piece
+var pieceValue = [7]int{0, 900, 500, 330, 320, 100, 0}
+
+var piecePhase = [7]int{0, 4, 2, 1, 1, 0, 0}
+
+// Piece-square bonuses from white's side, laid out as seen from white
+// with a8 first, indexed by Piece. Kings use the middlegame table.
+var pieceSquare = [6][64]int{
+	{
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-20, -30, -30, -40, -40, -30, -30, -20,
+		-10, -20, -20, -20, -20, -20, -20, -10,
+		 20,  20,   0,   0,   0,   0,  20,  20,
+		 20,  30,  10,   0,   0,  10,  30,  20,
+	},
+	{
+		-20, -10, -10,  -5,  -5, -10, -10, -20,
+		-10,   0,   0,   0,   0,   0,   0, -10,
+		-10,   0,   5,   5,   5,   5,   0, -10,
+		 -5,   0,   5,   5,   5,   5,   0,  -5,
+		  0,   0,   5,   5,   5,   5,   0,  -5,
+		-10,   5,   5,   5,   5,   5,   0, -10,
+		-10,   0,   5,   0,   0,   0,   0, -10,
+		-20, -10, -10,  -5,  -5, -10, -10, -20,
+	},
+	{
+		  0,   0,   0,   0,   0,   0,   0,   0,
+		  5,  10,  10,  10,  10,  10,  10,   5,
+		 -5,   0,   0,   0,   0,   0,   0,  -5,
+		 -5,   0,   0,   0,   0,   0,   0,  -5,
+		 -5,   0,   0,   0,   0,   0,   0,  -5,
+		 -5,   0,   0,   0,   0,   0,   0,  -5,
+		 -5,   0,   0,   0,   0,   0,   0,  -5,
+		  0,   0,   0,   5,   5,   0,   0,   0,
+	},
+	{
+		-20, -10, -10, -10, -10, -10, -10, -20,
+		-10,   0,   0,   0,   0,   0,   0, -10,
+		-10,   0,   5,  10,  10,   5,   0, -10,
+		-10,   5,   5,  10,  10,   5,   5, -10,
+		-10,   0,  10,  10,  10,  10,   0, -10,
+		-10,  10,  10,  10,  10,  10,  10, -10,
+		-10,   5,   0,   0,   0,   0,   5, -10,
+		-20, -10, -10, -10, -10, -10, -10, -20,
+	},
+	{
+		-50, -40, -30, -30, -30, -30, -40, -50,
+		-40, -20,   0,   0,   0,   0, -20, -40,
+		-30,   0,  10,  15,  15,  10,   0, -30,
+		-30,   5,  15,  20,  20,  15,   5, -30,
+		-30,   0,  15,  20,  20,  15,   0, -30,
+		-30,   5,  10,  15,  15,  10,   5, -30,
+		-40, -20,   0,   5,   5,   0, -20, -40,
+		-50, -40, -30, -30, -30, -30, -40, -50,
+	},
+	{
+		  0,   0,   0,   0,   0,   0,   0,   0,
+		 50,  50,  50,  50,  50,  50,  50,  50,
+		 10,  10,  20,  30,  30,  20,  10,  10,
+		  5,   5,  10,  25,  25,  10,   5,   5,
+		  0,   0,   0,  20,  20,   0,   0,   0,
+		  5,  -5, -10,   0,   0, -10,  -5,   5,
+		  5,  10,  10, -20, -20,  10,  10,   5,
+		  0,   0,   0,   0,   0,   0,   0,   0,
+	},
+}
 
-			if beta <= alpha {
-				break
-			}
-		}
-		return best, bestMove
-	}
+// Kings head for the centre once the pieces come off
+var kingEndgame = [64]int{
+	-50, -40, -30, -20, -20, -30, -40, -50,
+	-30, -20, -10,   0,   0, -10, -20, -30,
+	-30, -10,  20,  30,  30,  20, -10, -30,
+	-30, -10,  30,  40,  40,  30, -10, -30,
+	-30, -10,  30,  40,  40,  30, -10, -30,
+	-30, -10,  20,  30,  30,  20, -10, -30,
+	-30, -30,   0,   0,   0,   0, -30, -30,
+	-50, -30, -30, -30, -30, -30, -30, -50,
 }
 
-func dividePerft(game *Game, depth int) int {
-	if depth == 0 {
-		return 1
+// Returns the index into the piece-square tables of color's piece on sqr
+func pieceSquareIndex(sqr uint8, color Color) uint8 {
+	if color == BLACK {
+		sqr ^= 56
 	}
+	return 63 - sqr
+}
 
-	var total int = 0
-	var moves []*Move = game.getValidMoves()
-
-	for _, move := range moves {
-		game.makeMove(move)
-		num := perft(game, depth - 1)
-		fmt.Printf("%s: %d\n", move.ToString(), num)
-		if move.ToString() == "a8a8" {
-			fmt.Println(move.flag)
+// Scores the position in centipawns from the side to move's view, by
+// material and piece placement
+func evaluate(game *Game) int {
+	var board *Board = game.board
+	var score [2]int
+	var king [2][2]int
+	var phase int = 0
+
+	for color := WHITE; color <= BLACK; color++ {
+		for piece := KING; piece < EMPTY; piece++ {
+			var bb uint64 = board.piece[piece] & board.color[color]
+			for bb != 0 {
+				var index uint8 = pieceSquareIndex(bitScanForward(bb), color)
+				if piece == KING {
+					king[color][0] = pieceSquare[KING][index]
+					king[color][1] = kingEndgame[index]
+				} else {
+					score[color] += pieceValue[piece] + pieceSquare[piece][index]
+					phase += piecePhase[piece]
+				}
+				bb &= bb - 1
+			}
 		}
-		total += num
-		game.undoMove()
 	}
 
-	return total
-}
-
-func perft(game *Game, depth int) int {
-	if depth == 0 {
-		return 1
+	if phase > MAX_PHASE {
+		phase = MAX_PHASE
 	}
-
-	var num int = 0
-	var moves []*Move = game.getValidMoves()
-
-	for _, move := range moves {
-		game.makeMove(move)
-		num += perft(game, depth - 1)
-		game.undoMove()
+	for color := WHITE; color <= BLACK; color++ {
+		score[color] += (king[color][0] * phase +
+						 king[color][1] * (MAX_PHASE - phase)) / MAX_PHASE
 	}
-
-	return num
-}
\ No newline at end of file
+	return score[game.turn] - score[oppColor[game.turn]]
+}
@@ -0,0 +1,385 @@
+package goengine
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Identifies an opening explorer database and its layout version
+const EXPLORER_MAGIC = "GCEXP001"
+
+// Bytes in the header: magic and number of records
+const EXPLORER_HEADER_SIZE = 8 + 8
+
+// Bytes per record: position hash, move, results, rating sums of the
+// players making the move and their opponents, and games with both rated
+const EXPLORER_RECORD_SIZE = 8 + 2 + 4 * 3 + 8 * 2 + 4
+
+// Plies of each game added to the tree unless told otherwise
+const DEFAULT_EXPLORER_PLIES = 30
+
+var ErrInvalidExplorer = errors.New("Invalid opening explorer database.")
+
+// How often a move was played from a position and how those games ended
+type ExplorerMove struct {
+	Move Move
+	SAN string
+	Games int
+	WhiteWins int
+	Draws int
+	BlackWins int
+	// Average ratings of the players who chose the move and of their
+	// opponents, or 0 if none of the games were rated
+	AverageRating int
+	AverageOpponent int
+}
+
+// Moves played from each position of a PGN collection, kept on disk and
+// searched without loading it
+type OpeningExplorer struct {
+	file *os.File
+	count int64
+}
+
+type explorerKey struct {
+	hash uint64
+	move uint16
+}
+
+type explorerStats struct {
+	results [3]uint32
+	ratingSum [2]uint64
+	rated uint32
+}
+
+// A move from a replayed game, before it is tallied
+type explorerSample struct {
+	key explorerKey
+	mover Color
+}
+
+func (move ExplorerMove) WhitePercent() float64 {
+	return percent(move.WhiteWins, move.Games)
+}
+
+func (move ExplorerMove) DrawPercent() float64 {
+	return percent(move.Draws, move.Games)
+}
+
+func (move ExplorerMove) BlackPercent() float64 {
+	return percent(move.BlackWins, move.Games)
+}
+
+func percent(count int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(count) / float64(total)
+}
+
+// Adds the first maxPlies of every game in the PGN files to the explorer
+// database at dbFile, creating it if needed. Games without a result are
+// left out.
+func BuildExplorer(ctx context.Context, pgnFiles []string, dbFile string,
+				   maxPlies int, pipeline *Pipeline) (PipelineReport, error) {
+	var tree map[explorerKey]*explorerStats = make(map[explorerKey]*explorerStats)
+	err := readExplorerTree(dbFile, tree)
+	if err != nil && !os.IsNotExist(err) {
+		return PipelineReport{}, err
+	}
+
+	var run Pipeline = *pipeline
+	run.Work = func(pgn *PGNGame) (interface{}, error) {
+		return explorerSamples(pgn, maxPlies)
+	}
+
+	var total PipelineReport
+	for _, pgnFile := range pgnFiles {
+		parser, err := OpenPGN(pgnFile, 0)
+		if err != nil {
+			return total, err
+		}
+
+		report, err := run.Run(ctx, parser, func(result PipelineResult) error {
+			if (result.Err != nil) || (stringToStatus(result.Game.Result) == IN_PLAY) {
+				return nil
+			}
+			tallyGame(tree, result.Game, result.Value.([]explorerSample))
+			return nil
+		})
+		parser.Close()
+
+		total.Games += report.Games
+		total.Errors = append(total.Errors, report.Errors...)
+		total.Elapsed += report.Elapsed
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, writeExplorerTree(dbFile, tree)
+}
+
+func explorerSamples(pgn *PGNGame, maxPlies int) ([]explorerSample, error) {
+	game, err := pgn.StartingGame()
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []explorerSample
+	var node *PGNNode = pgn.Root.Next()
+	for ply := 0; (node != nil) && (ply < maxPlies); ply++ {
+		samples = append(samples, explorerSample{
+			key   : explorerKey{hash: game.Hash(), move: node.Move.short()},
+			mover : game.turn,
+		})
+		game.makeMove(node.Move)
+		node = node.Next()
+	}
+	return samples, nil
+}
+
+func tallyGame(tree map[explorerKey]*explorerStats, pgn *PGNGame,
+			   samples []explorerSample) {
+	var result int
+	switch stringToStatus(pgn.Result) {
+	case WHITE_WON:
+		result = 0
+	case DRAW:
+		result = 1
+	case BLACK_WON:
+		result = 2
+	}
+
+	var ratings [2]uint64
+	white, whiteErr := strconv.ParseUint(pgn.Tag("WhiteElo"), 10, 16)
+	black, blackErr := strconv.ParseUint(pgn.Tag("BlackElo"), 10, 16)
+	var rated bool = (whiteErr == nil) && (blackErr == nil)
+	ratings[WHITE], ratings[BLACK] = white, black
+
+	for _, sample := range samples {
+		var stats *explorerStats = tree[sample.key]
+		if stats == nil {
+			stats = &explorerStats{}
+			tree[sample.key] = stats
+		}
+
+		stats.results[result]++
+		if rated {
+			stats.ratingSum[0] += ratings[sample.mover]
+			stats.ratingSum[1] += ratings[oppColor[sample.mover]]
+			stats.rated++
+		}
+	}
+}
+
+func readExplorerTree(dbFile string, tree map[explorerKey]*explorerStats) error {
+	explorer, err := OpenExplorer(dbFile)
+	if err != nil {
+		return err
+	}
+	defer explorer.Close()
+
+	var reader *bufio.Reader = bufio.NewReader(io.NewSectionReader(explorer.file,
+		EXPLORER_HEADER_SIZE, explorer.count * EXPLORER_RECORD_SIZE))
+	for i := int64(0); i < explorer.count; i++ {
+		var record [EXPLORER_RECORD_SIZE]byte
+		_, err = io.ReadFull(reader, record[:])
+		if err != nil {
+			return err
+		}
+		key, stats := decodeExplorerRecord(record[:])
+		tree[key] = &stats
+	}
+	return nil
+}
+
+// Writes the tree sorted by position, replacing dbFile only once the new
+// database is complete
+func writeExplorerTree(dbFile string, tree map[explorerKey]*explorerStats) error {
+	var keys []explorerKey = make([]explorerKey, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i int, j int) bool {
+		if keys[i].hash != keys[j].hash {
+			return keys[i].hash < keys[j].hash
+		}
+		return keys[i].move < keys[j].move
+	})
+
+	var tmpFile string = dbFile + ".tmp"
+	file, err := os.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile)
+	defer file.Close()
+
+	var writer *bufio.Writer = bufio.NewWriter(file)
+	var header [EXPLORER_HEADER_SIZE]byte
+	copy(header[:], EXPLORER_MAGIC)
+	binary.LittleEndian.PutUint64(header[8:], uint64(len(keys)))
+	writer.Write(header[:])
+
+	for _, key := range keys {
+		var stats *explorerStats = tree[key]
+		var record [EXPLORER_RECORD_SIZE]byte
+		binary.LittleEndian.PutUint64(record[0:], key.hash)
+		binary.LittleEndian.PutUint16(record[8:], key.move)
+		for i, count := range stats.results {
+			binary.LittleEndian.PutUint32(record[10 + 4 * i:], count)
+		}
+		binary.LittleEndian.PutUint64(record[22:], stats.ratingSum[0])
+		binary.LittleEndian.PutUint64(record[30:], stats.ratingSum[1])
+		binary.LittleEndian.PutUint32(record[38:], stats.rated)
+		writer.Write(record[:])
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, dbFile)
+}
+
+func decodeExplorerRecord(record []byte) (explorerKey, explorerStats) {
+	var key explorerKey = explorerKey{
+		hash : binary.LittleEndian.Uint64(record[0:]),
+		move : binary.LittleEndian.Uint16(record[8:]),
+	}
+
+	var stats explorerStats
+	for i := range stats.results {
+		stats.results[i] = binary.LittleEndian.Uint32(record[10 + 4 * i:])
+	}
+	stats.ratingSum[0] = binary.LittleEndian.Uint64(record[22:])
+	stats.ratingSum[1] = binary.LittleEndian.Uint64(record[30:])
+	stats.rated = binary.LittleEndian.Uint32(record[38:])
+	return key, stats
+}
+
+// Opens a database written by BuildExplorer
+func OpenExplorer(dbFile string) (*OpeningExplorer, error) {
+	file, err := os.Open(dbFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var header [EXPLORER_HEADER_SIZE]byte
+	_, err = io.ReadFull(file, header[:])
+	if (err != nil) || (string(header[:8]) != EXPLORER_MAGIC) {
+		file.Close()
+		return nil, ErrInvalidExplorer
+	}
+
+	var explorer *OpeningExplorer = &OpeningExplorer{
+		file  : file,
+		count : int64(binary.LittleEndian.Uint64(header[8:])),
+	}
+	info, err := file.Stat()
+	if (err != nil) ||
+	   (info.Size() != EXPLORER_HEADER_SIZE + explorer.count * EXPLORER_RECORD_SIZE) {
+		file.Close()
+		return nil, ErrInvalidExplorer
+	}
+	return explorer, nil
+}
+
+func (explorer *OpeningExplorer) Close() error {
+	return explorer.file.Close()
+}
+
+// Returns the moves played from the game's current position, most played
+// first
+func (explorer *OpeningExplorer) Explore(game *Game) ([]ExplorerMove, error) {
+	var hash uint64 = game.Hash()
+	var readErr error
+	var read func(i int64) []byte = func(i int64) []byte {
+		var record []byte = make([]byte, EXPLORER_RECORD_SIZE)
+		_, err := explorer.file.ReadAt(record,
+			EXPLORER_HEADER_SIZE + i * EXPLORER_RECORD_SIZE)
+		if err != nil {
+			readErr = err
+		}
+		return record
+	}
+
+	var first int64 = int64(sort.Search(int(explorer.count), func(i int) bool {
+		return binary.LittleEndian.Uint64(read(int64(i))) >= hash
+	}))
+
+	// Moves are matched against legal ones, which also guards against
+	// hash collisions
+	var legal map[uint16]Move = make(map[uint16]Move)
+	for _, move := range game.LegalMoves() {
+		legal[move.short()] = move
+	}
+
+	var moves []ExplorerMove
+	for i := first; (i < explorer.count) && (readErr == nil); i++ {
+		key, stats := decodeExplorerRecord(read(i))
+		if key.hash != hash {
+			break
+		}
+		move, ok := legal[key.move]
+		if !ok {
+			continue
+		}
+
+		var entry ExplorerMove = ExplorerMove{
+			Move      : move,
+			SAN       : game.SAN(move),
+			Games     : int(stats.results[0] + stats.results[1] + stats.results[2]),
+			WhiteWins : int(stats.results[0]),
+			Draws     : int(stats.results[1]),
+			BlackWins : int(stats.results[2]),
+		}
+		if stats.rated > 0 {
+			entry.AverageRating = int(stats.ratingSum[0] / uint64(stats.rated))
+			entry.AverageOpponent = int(stats.ratingSum[1] / uint64(stats.rated))
+		}
+		moves = append(moves, entry)
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	sort.SliceStable(moves, func(i int, j int) bool {
+		return moves[i].Games > moves[j].Games
+	})
+	return moves, nil
+}
+
+// Lays out explorer moves as a table, one move per line
+func FormatExplorerMoves(moves []ExplorerMove) string {
+	if len(moves) == 0 {
+		return "No games reached this position.\n"
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%-8s %8s %7s %7s %7s %7s\n", "Move", "Games",
+				"White", "Draw", "Black", "Rating")
+	for _, move := range moves {
+		var rating string = "-"
+		if move.AverageRating > 0 {
+			rating = strconv.Itoa(move.AverageRating)
+		}
+		fmt.Fprintf(&builder, "%-8s %8d %6.1f%% %6.1f%% %6.1f%% %7s\n",
+					move.SAN, move.Games, move.WhitePercent(),
+					move.DrawPercent(), move.BlackPercent(), rating)
+	}
+	return builder.String()
+}
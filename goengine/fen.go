@@ -0,0 +1,294 @@
+package goengine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Piece letters in the order of the Piece constants
+const FEN_PIECES = "KQRBNP"
+
+// Returns the current position in Shredder-FEN, which names castling
+// rights by the rook's file, e.g. HAha instead of KQkq
+func (game *Game) ShredderFEN() string {
+	return game.formatFEN(true)
+}
+
+func (game *Game) getFENString() string {
+	return game.formatFEN(false)
+}
+
+// Writes the position as FEN, or as Shredder-FEN if shredder is set.
+// Castling rooks always start in the corners, so the FEN output is also
+// valid X-FEN and Shredder-FEN only ever names the a and h files.
+func (game *Game) formatFEN(shredder bool) string {
+	var board *Board = game.board
+	var fen string = board.getFENBoard()
+	fen += " " + colorToString[game.turn] + " "
+
+	var castling string = ""
+	var letters [2][2]string = [2][2]string{{"K", "Q"}, {"k", "q"}}
+	if shredder {
+		letters = [2][2]string{{"H", "A"}, {"h", "a"}}
+	}
+	for color := WHITE; color <= BLACK; color++ {
+		if (board.castle[color] & K_CASTLE_MASK) != 0 {
+			castling += letters[color][0]
+		}
+		if (board.castle[color] & Q_CASTLE_MASK) != 0 {
+			castling += letters[color][1]
+		}
+	}
+	if castling == "" {
+		castling = "-"
+	}
+	fen += castling + " "
+
+	// The skipped square is the empty one of the two ep bits
+	if (board.ep & board.piece[EMPTY]) != 0 {
+		fen += sqrToString(bitScanForward(board.ep & board.piece[EMPTY]))
+	} else {
+		fen += "-"
+	}
+
+	fen += fmt.Sprintf(" %d %d", game.halfmove, game.fullmove)
+	return fen
+}
+
+// Sets up the game at the position given in FEN, X-FEN or Shredder-FEN.
+// The clocks may be left out, as in EPD. The game is left untouched if
+// the string is malformed or describes an impossible position.
+func (game *Game) setFENString(fen string) error {
+	var fenData []string = strings.Fields(fen)
+	if len(fenData) < 4 {
+		var fields = [4]string{"board", "game turn", "castling", "En Passant"}
+		return &FENError{Field: fields[len(fenData)]}
+	} else if len(fenData) == 5 {
+		return &FENError{Field: "full move"}
+	} else if len(fenData) > 6 {
+		return &FENError{Field: "trailing", Value: strings.Join(fenData[6:], " ")}
+	}
+
+	var board *Board = new(Board)
+	err := board.parseFENBoard(fenData[0])
+	if err != nil {
+		return err
+	}
+
+	var turn Color
+	switch fenData[1] {
+	case "w":
+		turn = WHITE
+	case "b":
+		turn = BLACK
+	default:
+		return &FENError{Field: "game turn", Value: fenData[1]}
+	}
+
+	err = board.validate(turn)
+	if err != nil {
+		return err
+	}
+	err = board.parseFENCastling(fenData[2])
+	if err != nil {
+		return err
+	}
+	err = board.parseFENEnPassant(fenData[3], turn)
+	if err != nil {
+		return err
+	}
+
+	var halfmove uint64 = 0
+	var fullmove uint64 = 1
+	if len(fenData) == 6 {
+		halfmove, err = strconv.ParseUint(fenData[4], 10, 16)
+		if err != nil {
+			return &FENError{Field: "half move", Value: fenData[4]}
+		}
+		fullmove, err = strconv.ParseUint(fenData[5], 10, 16)
+		if err != nil || fullmove == 0 {
+			return &FENError{Field: "full move", Value: fenData[5]}
+		}
+	}
+
+	board.hash = board.computeHash(turn)
+	*game = Game{
+		initFEN  : fen,
+		board    : board,
+		turn     : turn,
+		halfmove : uint16(halfmove),
+		fullmove : uint16(fullmove),
+	}
+	return nil
+}
+
+// Reads the piece placement field, ranks 8 to 1 separated by slashes
+func (board *Board) parseFENBoard(field string) error {
+	var ranks []string = strings.Split(field, "/")
+	if len(ranks) != 8 {
+		return &FENError{Field: "board", Value: field,
+						 Reason: "expected 8 ranks"}
+	}
+
+	for i, rank := range ranks {
+		// Index of the rank's a-file square
+		var start int = ((7 - i) * 8) + 7
+		var file int = 0
+		var lastDigit bool = false
+		for _, char := range rank {
+			if (char >= '1') && (char <= '8') && !lastDigit {
+				file += int(char - '0')
+				lastDigit = true
+				continue
+			}
+
+			var piece int = strings.IndexRune(FEN_PIECES, unicode.ToUpper(char))
+			if (piece < 0) || (file >= 8) {
+				return &FENError{Field: "board", Value: rank}
+			}
+
+			var bb uint64 = 1 << uint(start - file)
+			board.piece[piece] |= bb
+			if unicode.IsUpper(char) {
+				board.color[WHITE] |= bb
+			} else {
+				board.color[BLACK] |= bb
+			}
+			file++
+			lastDigit = false
+		}
+
+		if file != 8 {
+			return &FENError{Field: "board", Value: rank,
+							 Reason: fmt.Sprintf("rank %d does not have 8 squares", 8 - i)}
+		}
+	}
+
+	board.piece[EMPTY] = board.findEmptySpaces()
+	return nil
+}
+
+// Checks that the position could arise in a game: one king each, no pawns
+// on the back ranks, no more pieces than promotions allow and the side
+// not to move is not in check
+func (board *Board) validate(turn Color) error {
+	var names [2]string = [2]string{"white", "black"}
+	for color := WHITE; color <= BLACK; color++ {
+		if popCount(board.getBB(KING, color)) != 1 {
+			return &FENError{Field: "board", Reason: fmt.Sprintf(
+				"%s must have exactly one king", names[color])}
+		}
+
+		// Every piece beyond the starting set must have been a pawn
+		var pawns int = int(popCount(board.getBB(PAWN, color)))
+		var promoted int = 0
+		var starting [6]int = [6]int{1, 1, 2, 2, 2, 8}
+		for piece := QUEEN; piece < PAWN; piece++ {
+			var extra int = int(popCount(board.getBB(piece, color))) - starting[piece]
+			if extra > 0 {
+				promoted += extra
+			}
+		}
+		if (pawns + promoted) > 8 {
+			return &FENError{Field: "board", Reason: fmt.Sprintf(
+				"%s has too many pieces", names[color])}
+		}
+	}
+
+	if (board.piece[PAWN] & EIGTH_RANK) != 0 {
+		return &FENError{Field: "board",
+						 Reason: "pawns cannot stand on the first or last rank"}
+	} else if board.isKingInCheck(oppColor[turn]) {
+		return &FENError{Field: "board", Reason: fmt.Sprintf(
+			"%s is in check but it is not their turn", names[oppColor[turn]])}
+	}
+	return nil
+}
+
+// Reads castling rights as KQkq, or as rook files in Shredder-FEN and
+// X-FEN. Only standard chess is played, so each right needs the king and
+// rook on their starting squares, and the files of Chess960 rooks that
+// start elsewhere are rejected.
+func (board *Board) parseFENCastling(field string) error {
+	board.castle = [2]uint8{0, 0}
+	if field == "-" {
+		return nil
+	}
+
+	for _, char := range field {
+		var color Color = WHITE
+		if unicode.IsLower(char) {
+			color = BLACK
+		}
+
+		var mask uint8
+		switch unicode.ToUpper(char) {
+		case 'K', 'H':
+			mask = K_CASTLE_MASK
+		case 'Q', 'A':
+			mask = Q_CASTLE_MASK
+		case 'B', 'C', 'D', 'E', 'F', 'G':
+			return &FENError{Field: "castling", Value: field,
+							 Reason: fmt.Sprintf("%q names a Chess960 rook, which is not supported", char)}
+		default:
+			return &FENError{Field: "castling", Value: field}
+		}
+
+		if (board.castle[color] & mask) != 0 {
+			return &FENError{Field: "castling", Value: field,
+							 Reason: fmt.Sprintf("%q is repeated", char)}
+		}
+		board.castle[color] |= mask
+
+		var rank uint8 = 56 * uint8(color)
+		var rook uint64 = 0x01 << rank
+		if mask == Q_CASTLE_MASK {
+			rook = 0x80 << rank
+		}
+		if ((board.getBB(KING, color) & (0x08 << rank)) == 0) ||
+		   ((board.getBB(ROOK, color) & rook) == 0) {
+			return &FENError{Field: "castling", Value: field,
+							 Reason: fmt.Sprintf("%q needs the king and rook on their starting squares", char)}
+		}
+	}
+	return nil
+}
+
+// Reads the square skipped by a double pawn push, which the pushed pawn
+// must sit in front of
+func (board *Board) parseFENEnPassant(field string, turn Color) error {
+	board.ep = 0
+	if field == "-" {
+		return nil
+	}
+
+	sqr, err := stringToSqr(field)
+	if err != nil {
+		return &FENError{Field: "En Passant", Value: field}
+	}
+
+	var target uint64 = 1 << sqr
+	var pushed, origin uint64
+	if turn == WHITE {
+		pushed, origin = moveSouth(target), moveNorth(target)
+	} else {
+		pushed, origin = moveNorth(target), moveSouth(target)
+	}
+
+	var rank uint8 = 5
+	if turn == BLACK {
+		rank = 2
+	}
+	if ((sqr / 8) != rank) ||
+	   ((pushed & board.getBB(PAWN, oppColor[turn])) == 0) ||
+	   ((target & board.piece[EMPTY]) == 0) ||
+	   ((origin & board.piece[EMPTY]) == 0) {
+		return &FENError{Field: "En Passant", Value: field,
+						 Reason: "no pawn could have just moved two squares past it"}
+	}
+
+	board.ep = target | pushed
+	return nil
+}
@@ -1,26 +1,50 @@
 package goengine
 
-import (
-	"fmt"
-	"strings"
-	"strconv"
-	"errors"
-)
-
 const START_FEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
 
 const ASCII_ROW_OFFSET = 49
 const ASCII_COL_OFFSET = 96
 
+// Tracks a chess game from its initial position, along with the moves
+// played since
 type Game struct {
 	initFEN string
 	board *Board
-	moves []*Move
+	moves []Move
+	undo []undoState
 	turn Color
-	halfmove uint8
-	fullmove uint8
-	points [2]int
+	halfmove uint16
+	fullmove uint16
 	status GameStatus
+	termination Termination
+}
+
+// Squares are indexed from h1 (0) to a8 (63)
+type Square uint8
+
+func (sqr Square) String() string {
+	return sqrToString(uint8(sqr))
+}
+
+// Parses a square in algebraic notation, e.g. e4
+func ParseSquare(str string) (Square, error) {
+	sqr, err := stringToSqr(str)
+	return Square(sqr), err
+}
+
+func sqrToString(sqr uint8) string {
+	return string([]byte{byte(7 - (sqr % 8)) + ASCII_COL_OFFSET + 1,
+						 byte(sqr / 8) + ASCII_ROW_OFFSET})
+}
+
+func stringToSqr(str string) (uint8, error) {
+	if len(str) != 2 || str[0] < 'a' || str[0] > 'h' ||
+	   str[1] < '1' || str[1] > '8' {
+		return 0, ErrInvalidSquare
+	}
+	var col uint8 = 7 - (str[0] - ASCII_COL_OFFSET - 1)
+	var row uint8 = str[1] - ASCII_ROW_OFFSET
+	return (row * 8) + col, nil
 }
 
 type GameStatus uint8
@@ -31,394 +55,271 @@ const (
 	DRAW
 )
 
-func (game *Game) setup() {
-	game.initFEN = START_FEN
-	game.board = new(Board)
-	game.board.setup()
-	game.fullmove = 1
-	game.turn = WHITE
+// Returns a game set up at the standard starting position
+func NewGame() *Game {
+	var game *Game = &Game{}
+	game.setup()
+	return game
 }
 
-func (game *Game) getFENString() string {
-	var fen string = game.board.getFENBoard()
-	fen += " "
-	fen += colorToString[game.turn]
-	fen += " "
-	if (game.board.castle[WHITE] | game.board.castle[BLACK]) != 0 {
-		if (game.board.castle[WHITE] & K_CASTLE_MASK) != 0 {
-			fen += "K"
-		}
-		if (game.board.castle[WHITE] & Q_CASTLE_MASK) != 0 {
-			fen += "Q"
-		}
-		if (game.board.castle[BLACK] & Q_CASTLE_MASK) != 0 {
-			fen += "k"
-		}
-		if (game.board.castle[BLACK] & Q_CASTLE_MASK) != 0 {
-			fen += "q"
-		}
-	} else {
-		fen += "-"
-	}
-	fen += " "
-	if game.board.ep != 0 {
-		var sqr uint64 = (game.board.ep ^ game.board.piece[PAWN]) & game.board.ep
-		var idx uint8 = bitScanForward(sqr)
-		// Convert row and column into algebraic notation
-		fen += string((idx / 8) + ASCII_COL_OFFSET)
-		fen += string((idx % 8) + ASCII_ROW_OFFSET)
-	} else {
-		fen += "-"
+// Returns a game set up at the position given in Forsyth-Edwards Notation
+func FromFEN(fen string) (*Game, error) {
+	var game *Game = NewGame()
+	err := game.setFENString(fen)
+	if err != nil {
+		return nil, err
 	}
-	fen += " "
-	fen += fmt.Sprintf("%d %d", game.halfmove, game.fullmove)
-	return fen
+	return game, nil
 }
 
-func (game *Game) setFENString(fen string) error {
-	game.initFEN = fen
-	game.moves = game.moves[:0]
-
-	var fenData []string = strings.Split(fen, " ")
-
-	// Set board position
-	game.board.setFENBoard(fenData[0])
-
-	// Set turn
-	if fenData[1] == "w" {
-		game.turn = WHITE
-	} else if fenData[1] == "b" {
-		game.turn = BLACK
-	} else {
-		errors.New("Invalid game turn data in FEN string.")
-	}
+// Returns the current position in Forsyth-Edwards Notation
+func (game *Game) FEN() string {
+	return game.getFENString()
+}
 
-	// Set castling rules
-	game.board.castle[WHITE] = 0
-	game.board.castle[BLACK] = 0
-	for _, value := range fenData[2] {
-		switch value {
-		case 'K':
-			game.board.castle[WHITE] |= K_CASTLE_MASK
-		case 'Q':
-			game.board.castle[WHITE] |= Q_CASTLE_MASK
-		case 'k':
-			game.board.castle[BLACK] |= K_CASTLE_MASK
-		case 'q':
-			game.board.castle[BLACK] |= Q_CASTLE_MASK
-		case '-':
-			break
-		default:
-			return errors.New("Invalid castling data in FEN string.")
-		}
-	}
+// Returns the color whose turn it is
+func (game *Game) SideToMove() Color {
+	return game.turn
+}
 
-	// Set ep square
-	if len(fenData[3]) == 2 {
-		col := 8 - (fenData[3][0] - ASCII_COL_OFFSET)
-		row := fenData[3][1] - ASCII_ROW_OFFSET
-		game.board.ep = 1 << uint64((row * 8) + col)
-		if game.turn == WHITE {
-			game.board.ep |= moveSouth(game.board.ep)
-		} else {
-			game.board.ep |= moveNorth(game.board.ep)
-		}
-	} else if fenData[3] != "-" {
-		return errors.New("Invalid En Passant data in FEN string.")
-	} else {
-		game.board.ep = 0
-	}
+// Returns true if the side to move is in check
+func (game *Game) InCheck() bool {
+	return game.board.isKingInCheck(game.turn)
+}
 
-	// If clock data is included
-	if len(fenData) > 4 {
-		// Set half move
-		data, err := strconv.ParseInt(fenData[4], 10, 8)
-		if err != nil {
-			return errors.New("Invalid half move data in FEN string.")
-		}
-		game.halfmove = uint8(data)
+// Returns whether the game is still in play, won or drawn
+func (game *Game) Status() GameStatus {
+	return game.getGameStatus()
+}
 
-		// Set full move
-		data, err = strconv.ParseInt(fenData[5], 10, 8)
-		if err != nil {
-			return errors.New("Invalid full move data in FEN string.")
-		}
-		game.fullmove = uint8(data)
-	} else {
-		game.halfmove = 0
-		game.fullmove = 1
-	}
+// Returns the piece and its color on the given square. Piece is EMPTY
+// if the square is unoccupied.
+func (game *Game) PieceAt(sqr Square) (Piece, Color) {
+	var bb uint64 = 1 << sqr
+	return game.board.findPiece(bb), game.board.findColor(bb)
+}
 
-	return nil
+// Returns every legal move for the side to move
+func (game *Game) LegalMoves() []Move {
+	return game.getValidMoves()
 }
 
-func (game *Game) pushSAN(cmd string) error {
-	var move *Move = new(Move)
-	move.color = game.turn
+// Returns the moves played since the game's initial position
+func (game *Game) History() []Move {
+	var moves []Move = make([]Move, len(game.moves))
+	copy(moves, game.moves)
+	return moves
+}
 
-	if cmd == "O-O" {
-		move.flag = K_CASTLE
-		move.piece = KING
-		err := game.handleMove(move)
-		if err != nil {
-			return err
-		}
-		return nil
-	} else if cmd == "O-O-O" {
-		move.flag = Q_CASTLE
-		move.piece = KING
-		err := game.handleMove(move)
-		if err != nil {
-			return err
+// Plays a move previously returned by LegalMoves
+func (game *Game) Push(move Move) error {
+	for _, legal := range game.getValidMoves() {
+		if legal == move {
+			game.makeMove(legal)
+			return nil
 		}
-		return nil
 	}
+	return ErrIllegalMove
+}
 
-	var cmdData []byte = []byte(cmd)
+// Plays a move given in standard algebraic notation, e.g. Nf3. Long
+// algebraic and coordinate notation are also accepted, see ParseMove.
+func (game *Game) PushSAN(san string) error {
+	return game.pushMove(san)
+}
 
-	// If move causes check, remove 
-	if cmdData[len(cmdData) - 1:][0] == byte('+') {
-		cmdData = cmdData[:len(cmdData) - 1]
-	}
+// Plays a move given in coordinate notation, e.g. g1f3. Algebraic
+// notation is also accepted, see ParseMove.
+func (game *Game) PushUCI(cmd string) error {
+	return game.pushMove(cmd)
+}
 
-	// Find square the piece is moving to
-	var toCol uint8 = 8 - (cmdData[len(cmdData) - 2:len(cmdData) - 1][0] - ASCII_COL_OFFSET)
-	var toRow uint8 = byte(cmdData[len(cmdData) - 1:][0]) - ASCII_ROW_OFFSET
-	move.to = 1 << ((toRow * 8) + toCol)
-
-	// Store additional info about piece
-	var additionalInfo []byte
-
-	// Determine type of piece being moved
-	var symbolExists bool
-	move.piece, symbolExists = runeToPiece[rune(cmdData[0])]
-	if !symbolExists {
-		move.piece = PAWN
-		additionalInfo = cmdData[0:len(cmdData) - 2]
-	} else {
-		additionalInfo = cmdData[1:len(cmdData) - 2]
+// Takes back the last move played and returns it
+func (game *Game) Pop() (Move, error) {
+	if len(game.moves) == 0 {
+		return NO_MOVE, ErrNoMoves
 	}
+	var move Move = game.moves[len(game.moves) - 1]
+	game.undoMove()
+	return move, nil
+}
 
-	// Search all possible pieces
-	var pieceBB uint64 = game.board.piece[move.piece]
-	pieceBB &= game.board.color[game.turn]
-
-	for pieceBB > 0 {
-		var sqr uint8 = bitScanForward(pieceBB)
-		var bb uint64 = 1 << sqr
-		move.from = bb
-		var set uint64 = game.board.getPieceSet(move.piece, bb, game.turn)
-		if (set & move.to) != 0 {
-			var valid bool = true
-			for i := 0; i < len(additionalInfo); i++ {
-				if (additionalInfo[i] >= byte('a')) &&
-				   (additionalInfo[i] <= byte('h')) {
-					col := 8 - (sqr % 8)
-					if col != (additionalInfo[i] - ASCII_COL_OFFSET) {
-						valid = false
-						break
-					}
-				} else if (additionalInfo[i] >= byte('1')) &&
-						  (additionalInfo[i]  <= byte('8')) {
-					row := sqr / 8
-					if row != (additionalInfo[i] - ASCII_ROW_OFFSET) {
-						valid = false
-						break
-					}
-				}
-			}
-
-			if valid {
-				err := game.handleMove(move)
-				if err != nil {
-					return err
-				}
-				return nil
-			}
-		}
+func (game *Game) setup() {
+	game.initFEN = START_FEN
+	game.board = new(Board)
+	game.board.setup()
+	game.fullmove = 1
+	game.turn = WHITE
+}
 
-		pieceBB ^= bb
+func (game *Game) copy() *Game {
+	var board Board = *game.board
+	var moves []Move = make([]Move, len(game.moves))
+	copy(moves, game.moves)
+	var undo []undoState = make([]undoState, len(game.undo))
+	copy(undo, game.undo)
+
+	return &Game{
+		initFEN  : game.initFEN,
+		board    : &board,
+		moves    : moves,
+		undo     : undo,
+		turn     : game.turn,
+		halfmove : game.halfmove,
+		fullmove : game.fullmove,
+		status   : game.status,
+		termination : game.termination,
 	}
-
-	return errors.New("Couldn't find piece to carry out move.")
 }
 
-func (game *Game) handleMove(move *Move) error {
-	move.fullmove = game.fullmove
-	move.halfmove = game.halfmove + 1
-	
-	err := game.board.processMove(move)
+// Plays a move given in any notation accepted by ParseMove
+func (game *Game) pushMove(notation string) error {
+	move, err := game.ParseMove(notation)
 	if err != nil {
 		return err
 	}
-
-	if move.color != game.turn {
-		return errors.New("Cannot move opponent's piece.")
-	}
-
 	game.makeMove(move)
-	if (game.board.isKingInCheck(move.color)) {
-		game.undoMove()
-		return errors.New("King would be in check.")
-	}
-
 	return nil
 }
 
-func (game *Game) makeMove(move *Move) {
-	// Modifying board based on move data
-	switch move.flag {
-	case QUIET:
-		game.board.quietMove(move)
-	case CAPTURE:
-		game.board.capture(move)
-	case K_CASTLE:
-		game.board.castleKingSide(move)
-	case Q_CASTLE:
-		game.board.castleQueenSide(move)
-	case PROMOTION:
-		if (move.target == PAWN) {
-			move.target = QUEEN
+func (game *Game) makeMove(move Move) {
+	var board *Board = game.board
+	game.moves = append(game.moves, move)
+	game.undo = append(game.undo, undoState{
+		castle   : board.castle,
+		ep       : board.ep,
+		halfmove : game.halfmove,
+		fullmove : game.fullmove,
+		hash     : board.hash,
+	})
+
+	// Remove castling, ep and side from hash before they change
+	board.hash ^= board.stateHash(game.turn)
+	board.applyMove(move)
+
+	var from uint64 = move.fromBB()
+	var to uint64 = move.toBB()
+	board.castle = updateCastleRights(board.castle, from | to)
+
+	// Double pushes leave the pushed pawn and skipped square as ep
+	board.ep = 0
+	if move.Piece() == PAWN {
+		if (moveNorth(moveNorth(from)) & to) != 0 {
+			board.ep = to | moveSouth(to)
+		} else if (moveSouth(moveSouth(from)) & to) != 0 {
+			board.ep = to | moveNorth(to)
 		}
-		game.board.quietMove(move)
-	case EP_CAPTURE:
-		game.board.epCapture(move)
 	}
 
-	game.board.ep = move.ep
-	game.board.castle[WHITE] = move.castle[WHITE]
-	game.board.castle[BLACK] = move.castle[BLACK]
+	game.halfmove++
+	if (move.Piece() == PAWN) || (move.Captured() != EMPTY) {
+		game.halfmove = 0
+	}
 	game.turn = oppColor[game.turn]
-	game.halfmove = move.halfmove
-	game.fullmove = move.fullmove
 	if game.turn == WHITE {
 		game.fullmove += 1
 	}
-	game.moves = append(game.moves, move)
+	board.hash ^= board.stateHash(game.turn)
+	game.debugHash()
 }
 
 func (game *Game) undoMove() {
-	// Popping last move from stack
-	var move *Move = game.moves[len(game.moves) - 1]
+	// Popping last move and its undo state from the stacks
+	var move Move = game.moves[len(game.moves) - 1]
+	var state undoState = game.undo[len(game.undo) - 1]
 	game.moves = game.moves[:len(game.moves) - 1]
-
-	var prevMove *Move = nil
-	if len(game.moves) > 0 {
-		prevMove = game.moves[len(game.moves) - 1]
-	}
+	game.undo = game.undo[:len(game.undo) - 1]
 
 	// Applying same board data to reverse last move
-	switch move.flag {
-	case QUIET:
-		game.board.quietMove(move)
-	case CAPTURE:
-		game.board.capture(move)
-	case K_CASTLE:
-		game.board.castleKingSide(move)
-	case Q_CASTLE:
-		game.board.castleQueenSide(move)
-	case PROMOTION:
-		if (move.target == PAWN) {
-			move.target = QUEEN
-		}
-		game.board.quietMove(move)
-	case EP_CAPTURE:
-		game.board.ep = prevMove.ep
-		game.board.epCapture(move)
-	}
+	game.board.applyMove(move)
 
-	// Setting relevant game variables to new, last move
-	if len(game.moves) > 0 {
-		move = game.moves[len(game.moves) - 1]
-		game.board.ep = move.ep
-		game.board.castle[WHITE] = move.castle[WHITE]
-		game.board.castle[BLACK] = move.castle[BLACK]
-		game.halfmove = move.halfmove
-		game.fullmove = move.fullmove
-		game.turn = oppColor[game.turn]
-	} else {
-		game.setFENString(game.initFEN)
-	}
+	game.board.castle = state.castle
+	game.board.ep = state.ep
+	game.board.hash = state.hash
+	game.halfmove = state.halfmove
+	game.fullmove = state.fullmove
+	game.turn = oppColor[game.turn]
+	game.debugHash()
 }
 
-func (game *Game) getValidMoves() []*Move {
-	var moves []*Move
-	for i := 0; i < 6; i++ {
-		moves = append(moves, game.getPieceMoves(Piece(i), game.turn)...)
+// Passes the turn without moving, recorded as NO_MOVE. Used by the search,
+// and by xboard to hand the move to the other side.
+func (game *Game) makeNullMove() {
+	var board *Board = game.board
+	game.moves = append(game.moves, NO_MOVE)
+	game.undo = append(game.undo, undoState{
+		castle   : board.castle,
+		ep       : board.ep,
+		halfmove : game.halfmove,
+		fullmove : game.fullmove,
+		hash     : board.hash,
+	})
+
+	board.hash ^= board.stateHash(game.turn)
+	board.ep = 0
+	game.halfmove++
+	game.turn = oppColor[game.turn]
+	if game.turn == WHITE {
+		game.fullmove += 1
 	}
+	board.hash ^= board.stateHash(game.turn)
+	game.debugHash()
+}
 
-	if game.board.canCastleKingSide(game.turn) {
-		var move *Move = new(Move)
-		move.flag = K_CASTLE
-		move.piece = KING
-		move.color = game.turn
-		moves = append(moves, move)
-	}
+func (game *Game) undoNullMove() {
+	var state undoState = game.undo[len(game.undo) - 1]
+	game.moves = game.moves[:len(game.moves) - 1]
+	game.undo = game.undo[:len(game.undo) - 1]
+
+	game.board.ep = state.ep
+	game.board.hash = state.hash
+	game.halfmove = state.halfmove
+	game.fullmove = state.fullmove
+	game.turn = oppColor[game.turn]
+	game.debugHash()
+}
 
-	if game.board.canCastleQueenSide(game.turn) {
-		var move *Move = new(Move)
-		move.flag = Q_CASTLE
-		move.piece = KING
-		move.color = game.turn
-		moves = append(moves, move)
+// Hands the move to color by passing, keeping the moves played so they can
+// still be taken back and repetitions found. The side to move cannot pass
+// out of check.
+func (game *Game) setSideToMove(color Color) error {
+	if game.turn == color {
+		return nil
+	} else if game.board.isKingInCheck(game.turn) {
+		return ErrKingInCheck
 	}
-	return moves
+	game.makeNullMove()
+	return nil
 }
 
-func (game *Game) getPieceMoves(piece Piece, color Color) []*Move {
-	var list []*Move
-	var pieceBB uint64 = game.board.getBB(piece, color)
-	for pieceBB != 0 {
-		// Get first piece set
-		var bb uint64 = 1 << bitScanForward(pieceBB)
-		var set uint64 = game.board.getPieceSet(piece, bb, color)
-
-		// Loop for every possible move in set
-		for set != 0 {
-			var move *Move = new(Move)
-			move.piece = piece
-			move.color = color
-			move.from = bb
-			move.to = 1 << bitScanForward(set)
-			err := game.handleMove(move)
-			if err == nil {
-				// If promotion, handle all possible promotions
-				// Else undo handled move and append to list
-				game.undoMove()
-				list = append(list, move)
-				if move.flag == PROMOTION {
-					potentialPromos := [3]Piece{ROOK, BISHOP, KNIGHT}
-					for _, promo := range potentialPromos {
-						move.target = promo
-						err = game.handleMove(move)
-						if err == nil {
-							game.undoMove()
-							list = append(list, move)
-						}
-					}
-				}
-			}
-			set ^= move.to
-		}
-		pieceBB ^= bb
+// Returns the last move played, or NO_MOVE if there is none or it was a
+// null move
+func (game *Game) lastMove() Move {
+	if len(game.moves) == 0 {
+		return NO_MOVE
 	}
+	return game.moves[len(game.moves) - 1]
+}
+
+func (game *Game) getValidMoves() []Move {
+	var list MoveList
+	game.generateMoves(&list)
 
-	return list
+	var moves []Move = make([]Move, list.count)
+	copy(moves, list.moves[:list.count])
+	return moves
 }
 
-func (game *Game) getGameStatus() GameStatus {
-	var moves []*Move = game.getValidMoves()
-
-	// If no legal moves, checkmate
-	if (len(moves) == 0) {
-		if (game.turn == WHITE) {
-			return BLACK_WON
-		} else {
-			return WHITE_WON 
-		}
-	}
+// Returns true if the side to move has at least one legal move
+func (game *Game) hasValidMoves() bool {
+	var list MoveList
+	game.generateMoves(&list)
+	return list.count > 0
+}
 
-	return IN_PLAY
+func (game *Game) getGameStatus() GameStatus {
+	status, _ := game.getResult()
+	return status
 }
 
 func (game *Game) setGameStatus(status string) {
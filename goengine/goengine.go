@@ -3,10 +3,14 @@ package goengine
 import (
 	"sync"
 	"fmt"
+	"strings"
 )
 
 type GoEngine struct {
 	game *Game
+	explorer *OpeningExplorer
+	table *TranspositionTable
+	options *SearchOptions
 	inputChan chan string
 	outputChan chan string
 }
@@ -19,51 +23,134 @@ func (engine *GoEngine) Setup(inputChan chan string, outputChan chan string) {
 	engine.game.setup()
 }
 
-func (engine *GoEngine) PGNToFEN(fileName string, numGames int) []string {
-	fen := make([]string, numGames)
-	games := scanGames(fileName, numGames)
+// Returns the final position of up to numGames games from a PGN file,
+// which may be compressed. Malformed games are skipped.
+func (engine *GoEngine) PGNToFEN(fileName string, numGames int) ([]string, error) {
+	games, err := scanGames(fileName, numGames)
+	fen := make([]string, len(games))
 	for i, game := range games {
 		fen[i] = game.getFENString()
 	}
-	return fen
+	return fen, err
+}
+
+// Returns the transposition table shared by the engine's searches,
+// allocating it on first use
+func (engine *GoEngine) transpositionTable() *TranspositionTable {
+	if engine.table == nil {
+		engine.table = NewTranspositionTable(DEFAULT_HASH_MB)
+	}
+	return engine.table
+}
+
+// Returns the selective search techniques the engine uses, all of them
+// until the GUI turns some off
+func (engine *GoEngine) searchOptions() *SearchOptions {
+	if engine.options == nil {
+		var options SearchOptions = DefaultSearchOptions()
+		engine.options = &options
+	}
+	return engine.options
+}
+
+// Lets the player look up the current position in an opening explorer
+// database with the "explore" command
+func (engine *GoEngine) SetExplorer(explorer *OpeningExplorer) {
+	engine.explorer = explorer
+}
+
+func (engine *GoEngine) explore() {
+	if engine.explorer == nil {
+		fmt.Println("No opening explorer database loaded.")
+		return
+	}
+
+	moves, err := engine.explorer.Explore(engine.game)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(FormatExplorerMoves(moves))
+}
+
+// Lists the side to move's pieces the opponent is threatening to win
+func (engine *GoEngine) warnHanging() {
+	var game *Game = engine.game
+	var hanging []string
+	for _, sqr := range game.HangingPieces(game.turn) {
+		piece, _ := game.PieceAt(sqr)
+		if piece == PAWN {
+			hanging = append(hanging, sqr.String())
+		} else {
+			hanging = append(hanging, pieceToString[WHITE][piece] + sqr.String())
+		}
+	}
+
+	if len(hanging) > 0 {
+		fmt.Printf("Hanging: %s\n", strings.Join(hanging, " "))
+	}
 }
 
 func (engine *GoEngine) Run(wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	engine.game.setFENString("8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - -")
-
+	// Side that offered a draw, open until the opponent's next action
+	var drawOffer Color = NO_COLOR
 	for {
-		//if engine.game.turn == WHITE {
-			fmt.Println(dividePerft(engine.game, 6))
-			fmt.Println(engine.game.getFENString())
-			engine.outputChan <- "client " + engine.game.getFENString()
-			cmd := <- engine.inputChan
-
-			err := engine.game.pushSAN(cmd)
-			if err != nil {
-				fmt.Println(err)
+		fmt.Println(engine.game.getFENString())
+		engine.warnHanging()
+		engine.outputChan <- "client " + engine.game.getFENString()
+		if engine.game.CanClaimDraw() {
+			fmt.Printf("Draw can be claimed by %s.\n", engine.game.ClaimableDraw())
+		}
+		cmd := <- engine.inputChan
+
+		var err error
+		switch cmd {
+		case "explore":
+			engine.explore()
+			continue
+		case "resign":
+			engine.game.Resign(engine.game.turn)
+		case "draw":
+			// Claim the draw if the rules allow, otherwise accept the
+			// opponent's offer or make one
+			if engine.game.CanClaimDraw() {
+				err = engine.game.ClaimDraw()
+			} else if drawOffer == oppColor[engine.game.turn] {
+				engine.game.AgreeDraw()
+			} else {
+				drawOffer = engine.game.turn
+				fmt.Println("Draw offered, the opponent may accept with draw.")
 				continue
 			}
-		//} else {
-			// engine.outputChan <- "engine " + engine.game.getFENString()
-			// _, move := minimax(engine.game, 5, true, MIN_INT, MAX_INT)
-			// engine.game.makeMove(move)
-		//}
-		var gameStatus GameStatus = engine.game.getGameStatus()
+		default:
+			var mover Color = engine.game.turn
+			err = engine.game.pushMove(cmd)
+			if (err == nil) && (drawOffer != mover) {
+				drawOffer = NO_COLOR
+			}
+		}
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		gameStatus, termination := engine.game.getResult()
 		switch (gameStatus) {
 		case WHITE_WON:
-			fmt.Println("White won!")
-			engine.outputChan <- "mate"
-			return
+			fmt.Printf("White won by %s!\n", termination)
 		case BLACK_WON:
-			fmt.Println("Black won!")
-			engine.outputChan <- "mate"
-			return
+			fmt.Printf("Black won by %s!\n", termination)
 		case DRAW:
-			fmt.Println("Draw!")
-			engine.outputChan <- "mate"
-			return
+			fmt.Printf("Draw by %s!\n", termination)
+		default:
+			continue
 		}
+
+		// Keep a record of the finished game
+		fmt.Printf("\n%s", engine.game.PGN())
+		engine.outputChan <- "mate"
+		return
 	}
 }
\ No newline at end of file
@@ -0,0 +1,610 @@
+package goengine
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Identifies a position index file and its layout version
+const INDEX_MAGIC = "GCIDX001"
+
+// Bytes in the header: magic, then the number of games, positions and
+// material signatures
+const INDEX_HEADER_SIZE = 8 + 4 + 8 + 8
+
+// Bytes per game: offset in the PGN, plies before the first move and
+// result
+const INDEX_GAME_SIZE = 8 + 2 + 1
+
+// Bytes per entry: key, game number and ply
+const INDEX_ENTRY_SIZE = 8 + 4 + 2
+
+// Entries sorted in memory before being written out as a run, which keeps
+// memory bounded however large the collection
+const INDEX_RUN_ENTRIES = 1 << 22
+
+// Sections of an index
+const (
+	POSITION_SECTION = iota
+	MATERIAL_SECTION
+)
+
+var ErrInvalidIndex = errors.New("Invalid position index file.")
+var ErrInvalidMaterial = errors.New("Invalid material signature.")
+
+// A position or material signature first reached in a game
+type indexEntry struct {
+	key uint64
+	game uint32
+	ply uint16
+}
+
+type indexedGame struct {
+	offset int64
+	startPly uint16
+	status GameStatus
+}
+
+// A game in which a queried position or material balance occurred
+type IndexMatch struct {
+	// Position of the game in the PGN collection, counting from 0
+	Game int
+	Offset int64
+	// Plies played in the game before the match, and the move number and
+	// side to move at that point
+	Ply int
+	MoveNumber int
+	SideToMove Color
+	Result GameStatus
+}
+
+// Results of the games matching a query
+type IndexStats struct {
+	Games int
+	WhiteWins int
+	Draws int
+	BlackWins int
+	Unfinished int
+}
+
+// An on-disk index from positions and material signatures to the games
+// of a PGN collection. Lookups binary search the file, so only the game
+// table is held in memory.
+type PositionIndex struct {
+	file *os.File
+	games []indexedGame
+	start [2]int64
+	count [2]int64
+}
+
+// Returns the material balance as a signature key: the number of each
+// piece but the king for both sides, four bits each
+func (board *Board) materialKey() uint64 {
+	var key uint64 = 0
+	for color := WHITE; color <= BLACK; color++ {
+		for piece := QUEEN; piece <= PAWN; piece++ {
+			var count uint64 = uint64(popCount(board.getBB(piece, color)))
+			if count > 15 {
+				count = 15
+			}
+			key = (key << 4) | count
+		}
+	}
+	return key
+}
+
+// Parses a material signature such as "KRPvKR" or "R+P vs R", white's
+// pieces first. Kings may be left out.
+func ParseMaterial(signature string) (uint64, error) {
+	signature = strings.Replace(strings.ToUpper(signature), "VS", "V", 1)
+	var sides []string = strings.Split(signature, "V")
+	if len(sides) != 2 {
+		return 0, ErrInvalidMaterial
+	}
+
+	var key uint64 = 0
+	for _, side := range sides {
+		var counts [6]uint64
+		for _, char := range side {
+			if (char == 'K') || (char == '+') || (char == ' ') {
+				continue
+			}
+			var piece int = strings.IndexRune(FEN_PIECES, char)
+			if piece < 0 {
+				return 0, ErrInvalidMaterial
+			}
+			counts[piece]++
+		}
+		for piece := QUEEN; piece <= PAWN; piece++ {
+			if counts[piece] > 15 {
+				return 0, ErrInvalidMaterial
+			}
+			key = (key << 4) | counts[piece]
+		}
+	}
+	return key, nil
+}
+
+// Swaps the sides of a material signature key
+func mirrorMaterial(key uint64) uint64 {
+	return (key >> 20) | ((key & 0xFFFFF) << 20)
+}
+
+// Indexes every position and material balance reached in the main line
+// of each game in a PGN file, writing the index to indexFile. Games are
+// replayed on the pipeline's workers.
+func BuildIndex(ctx context.Context, pgnFile string, indexFile string,
+				pipeline *Pipeline) (PipelineReport, error) {
+	parser, err := OpenPGN(pgnFile, 0)
+	if err != nil {
+		return PipelineReport{}, err
+	}
+	defer parser.Close()
+
+	dir, err := ioutil.TempDir(filepath.Dir(indexFile), "index")
+	if err != nil {
+		return PipelineReport{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	var builder *indexBuilder = &indexBuilder{dir: dir}
+	var run Pipeline = *pipeline
+	run.Work = indexGame
+	report, err := run.Run(ctx, parser, func(result PipelineResult) error {
+		// Results may come back out of order, so games are kept by their
+		// place in the stream that entries refer to
+		for len(builder.games) <= result.Index {
+			builder.games = append(builder.games, indexedGame{})
+		}
+		var game *indexedGame = &builder.games[result.Index]
+		game.offset = result.Offset
+		if result.Err != nil {
+			return nil
+		}
+
+		var entries indexedEntries = result.Value.(indexedEntries)
+		game.startPly = entries.startPly
+		game.status = stringToStatus(result.Game.Result)
+		for section := range entries.entries {
+			for _, entry := range entries.entries[section] {
+				entry.game = uint32(result.Index)
+				err := builder.add(section, entry)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	return report, builder.write(indexFile)
+}
+
+type indexedEntries struct {
+	startPly uint16
+	entries [2][]indexEntry
+}
+
+// Replays a game, keeping the first ply each position and material
+// balance was reached
+func indexGame(pgn *PGNGame) (interface{}, error) {
+	game, err := pgn.StartingGame()
+	if err != nil {
+		return nil, err
+	}
+
+	var indexed indexedEntries = indexedEntries{
+		startPly : (game.fullmove - 1) * 2 + uint16(game.turn),
+	}
+	var seen [2]map[uint64]bool = [2]map[uint64]bool{
+		make(map[uint64]bool), make(map[uint64]bool)}
+	var node *PGNNode = pgn.Root
+	for ply := 0; node != nil; ply++ {
+		var keys [2]uint64 = [2]uint64{game.Hash(), game.board.materialKey()}
+		for section, key := range keys {
+			if !seen[section][key] {
+				seen[section][key] = true
+				indexed.entries[section] = append(indexed.entries[section],
+					indexEntry{key: key, ply: uint16(ply)})
+			}
+		}
+
+		node = node.Next()
+		if node != nil {
+			game.makeMove(node.Move)
+		}
+	}
+	return indexed, nil
+}
+
+func stringToStatus(result string) GameStatus {
+	for status, str := range statusToString {
+		if str == result {
+			return GameStatus(status)
+		}
+	}
+	return IN_PLAY
+}
+
+// Gathers entries into sorted runs on disk, then merges them into the
+// index file
+type indexBuilder struct {
+	dir string
+	games []indexedGame
+	buffers [2][]indexEntry
+	runs [2][]string
+	count [2]int64
+}
+
+func (builder *indexBuilder) add(section int, entry indexEntry) error {
+	builder.buffers[section] = append(builder.buffers[section], entry)
+	builder.count[section]++
+	if len(builder.buffers[section]) >= INDEX_RUN_ENTRIES {
+		return builder.flush(section)
+	}
+	return nil
+}
+
+func (builder *indexBuilder) flush(section int) error {
+	var entries []indexEntry = builder.buffers[section]
+	if len(entries) == 0 {
+		return nil
+	}
+	sort.Slice(entries, func(i int, j int) bool {
+		return entries[i].less(entries[j])
+	})
+
+	file, err := ioutil.TempFile(builder.dir, "run")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var writer *bufio.Writer = bufio.NewWriter(file)
+	for _, entry := range entries {
+		_, err = writer.Write(entry.encode())
+		if err != nil {
+			return err
+		}
+	}
+	builder.runs[section] = append(builder.runs[section], file.Name())
+	builder.buffers[section] = entries[:0]
+	return writer.Flush()
+}
+
+func (builder *indexBuilder) write(fileName string) error {
+	for section := range builder.buffers {
+		err := builder.flush(section)
+		if err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = builder.writeTo(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (builder *indexBuilder) writeTo(out io.Writer) error {
+	var writer *bufio.Writer = bufio.NewWriter(out)
+	var header [INDEX_HEADER_SIZE]byte
+	copy(header[:], INDEX_MAGIC)
+	binary.LittleEndian.PutUint32(header[8:], uint32(len(builder.games)))
+	binary.LittleEndian.PutUint64(header[12:], uint64(builder.count[POSITION_SECTION]))
+	binary.LittleEndian.PutUint64(header[20:], uint64(builder.count[MATERIAL_SECTION]))
+	_, err := writer.Write(header[:])
+	if err != nil {
+		return err
+	}
+
+	for _, game := range builder.games {
+		var record [INDEX_GAME_SIZE]byte
+		binary.LittleEndian.PutUint64(record[0:], uint64(game.offset))
+		binary.LittleEndian.PutUint16(record[8:], game.startPly)
+		record[10] = byte(game.status)
+		_, err = writer.Write(record[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	for section := range builder.runs {
+		err = mergeRuns(builder.runs[section], writer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+// Merges sorted runs into one sorted stream
+func mergeRuns(runs []string, writer io.Writer) error {
+	var queue *runQueue = &runQueue{}
+	for _, run := range runs {
+		file, err := os.Open(run)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		var reader *runReader = &runReader{reader: bufio.NewReader(file)}
+		ok, err := reader.next()
+		if err != nil {
+			return err
+		} else if ok {
+			*queue = append(*queue, reader)
+		}
+	}
+	heap.Init(queue)
+
+	for queue.Len() > 0 {
+		var reader *runReader = (*queue)[0]
+		_, err := writer.Write(reader.entry.encode())
+		if err != nil {
+			return err
+		}
+
+		ok, err := reader.next()
+		if err != nil {
+			return err
+		} else if ok {
+			heap.Fix(queue, 0)
+		} else {
+			heap.Pop(queue)
+		}
+	}
+	return nil
+}
+
+type runReader struct {
+	reader *bufio.Reader
+	entry indexEntry
+}
+
+func (run *runReader) next() (bool, error) {
+	var record [INDEX_ENTRY_SIZE]byte
+	_, err := io.ReadFull(run.reader, record[:])
+	if err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	run.entry = decodeIndexEntry(record[:])
+	return true, nil
+}
+
+// Heap of runs ordered by their current entry
+type runQueue []*runReader
+
+func (queue runQueue) Len() int {
+	return len(queue)
+}
+
+func (queue runQueue) Less(i int, j int) bool {
+	return queue[i].entry.less(queue[j].entry)
+}
+
+func (queue runQueue) Swap(i int, j int) {
+	queue[i], queue[j] = queue[j], queue[i]
+}
+
+func (queue *runQueue) Push(run interface{}) {
+	*queue = append(*queue, run.(*runReader))
+}
+
+func (queue *runQueue) Pop() interface{} {
+	var old runQueue = *queue
+	var run *runReader = old[len(old) - 1]
+	*queue = old[:len(old) - 1]
+	return run
+}
+
+func (entry indexEntry) less(other indexEntry) bool {
+	if entry.key != other.key {
+		return entry.key < other.key
+	}
+	return entry.game < other.game
+}
+
+func (entry indexEntry) encode() []byte {
+	var record []byte = make([]byte, INDEX_ENTRY_SIZE)
+	binary.LittleEndian.PutUint64(record[0:], entry.key)
+	binary.LittleEndian.PutUint32(record[8:], entry.game)
+	binary.LittleEndian.PutUint16(record[12:], entry.ply)
+	return record
+}
+
+func decodeIndexEntry(record []byte) indexEntry {
+	return indexEntry{
+		key  : binary.LittleEndian.Uint64(record[0:]),
+		game : binary.LittleEndian.Uint32(record[8:]),
+		ply  : binary.LittleEndian.Uint16(record[12:]),
+	}
+}
+
+// Opens an index written by BuildIndex
+func OpenIndex(fileName string) (*PositionIndex, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := readIndex(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return index, nil
+}
+
+func readIndex(file *os.File) (*PositionIndex, error) {
+	var header [INDEX_HEADER_SIZE]byte
+	_, err := io.ReadFull(file, header[:])
+	if (err != nil) || (string(header[:8]) != INDEX_MAGIC) {
+		return nil, ErrInvalidIndex
+	}
+
+	var index *PositionIndex = &PositionIndex{file: file}
+	var games int = int(binary.LittleEndian.Uint32(header[8:]))
+	index.count[POSITION_SECTION] = int64(binary.LittleEndian.Uint64(header[12:]))
+	index.count[MATERIAL_SECTION] = int64(binary.LittleEndian.Uint64(header[20:]))
+	index.start[POSITION_SECTION] = INDEX_HEADER_SIZE + int64(games) * INDEX_GAME_SIZE
+	index.start[MATERIAL_SECTION] = index.start[POSITION_SECTION] +
+		index.count[POSITION_SECTION] * INDEX_ENTRY_SIZE
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	} else if info.Size() != index.start[MATERIAL_SECTION] +
+		index.count[MATERIAL_SECTION] * INDEX_ENTRY_SIZE {
+		return nil, ErrInvalidIndex
+	}
+
+	var reader *bufio.Reader = bufio.NewReader(file)
+	index.games = make([]indexedGame, games)
+	for i := range index.games {
+		var record [INDEX_GAME_SIZE]byte
+		_, err = io.ReadFull(reader, record[:])
+		if err != nil {
+			return nil, ErrInvalidIndex
+		}
+		index.games[i] = indexedGame{
+			offset   : int64(binary.LittleEndian.Uint64(record[0:])),
+			startPly : binary.LittleEndian.Uint16(record[8:]),
+			status   : GameStatus(record[10]),
+		}
+	}
+	return index, nil
+}
+
+func (index *PositionIndex) Close() error {
+	return index.file.Close()
+}
+
+// Number of games in the indexed collection, malformed ones included
+func (index *PositionIndex) Games() int {
+	return len(index.games)
+}
+
+// Returns every game in which the position occurred, in collection order
+func (index *PositionIndex) Find(fen string) ([]IndexMatch, error) {
+	game, err := FromFEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	return index.lookup(POSITION_SECTION, game.Hash())
+}
+
+// Returns every game that reached the material balance of the signature,
+// e.g. "KRPvKR", with either side holding either set of pieces
+func (index *PositionIndex) FindMaterial(signature string) ([]IndexMatch, error) {
+	key, err := ParseMaterial(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := index.lookup(MATERIAL_SECTION, key)
+	if (err != nil) || (mirrorMaterial(key) == key) {
+		return matches, err
+	}
+	mirrored, err := index.lookup(MATERIAL_SECTION, mirrorMaterial(key))
+	if err != nil {
+		return nil, err
+	}
+
+	// Games reaching both balances are reported at the earliest
+	matches = append(matches, mirrored...)
+	sort.SliceStable(matches, func(i int, j int) bool {
+		return matches[i].Game < matches[j].Game
+	})
+	var unique []IndexMatch
+	for _, match := range matches {
+		if (len(unique) > 0) && (unique[len(unique) - 1].Game == match.Game) {
+			if match.Ply < unique[len(unique) - 1].Ply {
+				unique[len(unique) - 1] = match
+			}
+			continue
+		}
+		unique = append(unique, match)
+	}
+	return unique, nil
+}
+
+// Binary searches a section for its first entry with the key, then reads
+// every entry with it
+func (index *PositionIndex) lookup(section int, key uint64) ([]IndexMatch, error) {
+	var readErr error
+	var read func(i int64) indexEntry = func(i int64) indexEntry {
+		var record [INDEX_ENTRY_SIZE]byte
+		_, err := index.file.ReadAt(record[:], index.start[section] + i * INDEX_ENTRY_SIZE)
+		if err != nil {
+			readErr = err
+		}
+		return decodeIndexEntry(record[:])
+	}
+
+	var first int64 = int64(sort.Search(int(index.count[section]), func(i int) bool {
+		return read(int64(i)).key >= key
+	}))
+
+	var matches []IndexMatch
+	for i := first; (i < index.count[section]) && (readErr == nil); i++ {
+		var entry indexEntry = read(i)
+		if entry.key != key {
+			break
+		} else if int(entry.game) >= len(index.games) {
+			return nil, ErrInvalidIndex
+		}
+
+		var game indexedGame = index.games[entry.game]
+		var ply int = int(game.startPly) + int(entry.ply)
+		matches = append(matches, IndexMatch{
+			Game       : int(entry.game),
+			Offset     : game.offset,
+			Ply        : int(entry.ply),
+			MoveNumber : ply / 2 + 1,
+			SideToMove : Color(ply % 2),
+			Result     : game.status,
+		})
+	}
+	return matches, readErr
+}
+
+// Tallies the results of the matched games
+func MatchStats(matches []IndexMatch) IndexStats {
+	var stats IndexStats = IndexStats{Games: len(matches)}
+	for _, match := range matches {
+		switch match.Result {
+		case WHITE_WON:
+			stats.WhiteWins++
+		case BLACK_WON:
+			stats.BlackWins++
+		case DRAW:
+			stats.Draws++
+		default:
+			stats.Unfinished++
+		}
+	}
+	return stats
+}
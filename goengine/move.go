@@ -1,46 +1,119 @@
 package goengine
 
-type Move struct {
-	flag Flag
-	from uint64
-	to uint64
-	piece Piece
-	target Piece
-	color Color
-	castle [2]uint8
-	ep uint64
-	fullmove uint8
-	halfmove uint8
-	points int
-}
-
-func (move *Move) copy() *Move {
-	return &Move{
-		flag     : move.flag,
-		from     : move.from,
-		to       : move.to,
-		piece    : move.piece,
-		target   : move.target,
-		color    : move.color,
-		castle   : move.castle,
-		ep       : move.ep,
-		halfmove : move.halfmove,
-		fullmove : move.fullmove,
-		points   : move.points,
+// A move packed into 32 bits, cheap to copy, compare and store. The low
+// 18 bits identify the move; the rest describe it so it can be made and
+// taken back without looking at the board.
+//
+//	bits  0-5   from square
+//	bits  6-11  to square
+//	bits 12-14  flag
+//	bits 15-17  promotion piece, or EMPTY
+//	bits 18-20  piece moved
+//	bits 21-23  piece captured, or EMPTY
+//	bit  24     color of the piece moved
+type Move uint32
+
+// Never a legal move, used where no move is available
+const NO_MOVE Move = 0
+
+const (
+	MOVE_FROM_SHIFT = 0
+	MOVE_TO_SHIFT = 6
+	MOVE_FLAG_SHIFT = 12
+	MOVE_PROMO_SHIFT = 15
+	MOVE_PIECE_SHIFT = 18
+	MOVE_CAPTURED_SHIFT = 21
+	MOVE_COLOR_SHIFT = 24
+)
+
+func newMove(flag Flag, piece Piece, color Color, from uint8, to uint8,
+			 captured Piece, promo Piece) Move {
+	return Move(uint32(from) << MOVE_FROM_SHIFT |
+				uint32(to) << MOVE_TO_SHIFT |
+				uint32(flag) << MOVE_FLAG_SHIFT |
+				uint32(promo) << MOVE_PROMO_SHIFT |
+				uint32(piece) << MOVE_PIECE_SHIFT |
+				uint32(captured) << MOVE_CAPTURED_SHIFT |
+				uint32(color) << MOVE_COLOR_SHIFT)
+}
+
+// Returns the move in coordinate notation, e.g. e2e4. Use Game.SAN for
+// standard algebraic notation.
+func (move Move) ToString() string {
+	return move.uciString()
+}
+
+// Square the piece moves from
+func (move Move) From() Square {
+	return Square((move >> MOVE_FROM_SHIFT) & 0x3F)
+}
+
+// Square the piece moves to. Castling moves give the king's destination.
+func (move Move) To() Square {
+	return Square((move >> MOVE_TO_SHIFT) & 0x3F)
+}
+
+// Piece being moved
+func (move Move) Piece() Piece {
+	return Piece((move >> MOVE_PIECE_SHIFT) & 0x07)
+}
+
+// Color of the piece being moved
+func (move Move) Color() Color {
+	return Color((move >> MOVE_COLOR_SHIFT) & 0x01)
+}
+
+// Piece captured by the move, or EMPTY
+func (move Move) Captured() Piece {
+	return Piece((move >> MOVE_CAPTURED_SHIFT) & 0x07)
+}
+
+// Piece a pawn promotes to, or EMPTY
+func (move Move) Promotion() Piece {
+	return Piece((move >> MOVE_PROMO_SHIFT) & 0x07)
+}
+
+// Type of the move
+func (move Move) Flag() Flag {
+	return Flag((move >> MOVE_FLAG_SHIFT) & 0x07)
+}
+
+// Returns the move in coordinate notation, e.g. e2e4 or e7e8q
+func (move Move) String() string {
+	return move.uciString()
+}
+
+func (move Move) uciString() string {
+	var str string = sqrToString(uint8(move.From())) +
+					 sqrToString(uint8(move.To()))
+	if move.Flag() == PROMOTION {
+		str += pieceToString[BLACK][move.Promotion()]
 	}
+	return str
 }
 
-func (move *Move) ToString() string {
-	var fromSqr uint8 = bitScanForward(move.from)
-	var toSqr uint8 = bitScanForward(move.to)
-	var startRow uint8 = fromSqr / 8
-	var startCol uint8 = fromSqr % 8
-	var endRow uint8 = toSqr / 8
-	var endCol uint8 = toSqr % 8
-	return (string((8 - startCol) + ASCII_COL_OFFSET) +
-	        string(startRow + ASCII_ROW_OFFSET) + 
-			string((8 - endCol) + ASCII_COL_OFFSET) +
-			string(endRow + ASCII_ROW_OFFSET))
+// Squares and promotion piece in 16 bits, enough to pick out the move
+// among the legal ones of its position
+func (move Move) short() uint16 {
+	return uint16(move.From()) | (uint16(move.To()) << 6) |
+		   (uint16(move.Promotion()) << 12)
+}
+
+func (move Move) fromBB() uint64 {
+	return 1 << move.From()
+}
+
+func (move Move) toBB() uint64 {
+	return 1 << move.To()
+}
+
+// Board state a move destroys, kept per ply so the move can be taken back
+type undoState struct {
+	castle [2]uint8
+	ep uint64
+	halfmove uint16
+	fullmove uint16
+	hash uint64
 }
 
 type Flag uint8
@@ -52,4 +125,4 @@ const (
 	Q_CASTLE
 	EP_CAPTURE
 	PROMOTION
-)
\ No newline at end of file
+)
@@ -0,0 +1,300 @@
+package goengine
+
+// Upper bound on legal moves in any reachable position
+const MAX_MOVES = 256
+
+// Which moves generate adds to the list
+type genType uint8
+const (
+	GEN_ALL genType = iota
+	// Captures, including en passant, and promotions
+	GEN_TACTICAL
+	// Every other move, including castling
+	GEN_QUIET
+)
+
+// Fixed-size buffer of moves, filled by generateMoves without allocating
+type MoveList struct {
+	moves [MAX_MOVES]Move
+	count int
+}
+
+// Squares strictly between two aligned squares, and the full line
+// through them, or zero if they share no rank, file or diagonal
+var betweenSqrs [64][64]uint64
+var lineSqrs [64][64]uint64
+
+func init() {
+	// Built here rather than in an init of its own, so the tables below
+	// never depend on the order files are initialised in
+	initMagics()
+
+	for a := uint8(0); a < 64; a++ {
+		for b := uint8(0); b < 64; b++ {
+			var aBB uint64 = 1 << a
+			var bBB uint64 = 1 << b
+			if a == b {
+				continue
+			} else if (rookAttacks(a, 0) & bBB) != 0 {
+				betweenSqrs[a][b] = rookAttacks(a, bBB) & rookAttacks(b, aBB)
+				lineSqrs[a][b] = (rookAttacks(a, 0) & rookAttacks(b, 0)) | aBB | bBB
+			} else if (bishopAttacks(a, 0) & bBB) != 0 {
+				betweenSqrs[a][b] = bishopAttacks(a, bBB) & bishopAttacks(b, aBB)
+				lineSqrs[a][b] = (bishopAttacks(a, 0) & bishopAttacks(b, 0)) | aBB | bBB
+			}
+		}
+	}
+}
+
+func (list *MoveList) Len() int {
+	return list.count
+}
+
+func (list *MoveList) At(i int) Move {
+	return list.moves[i]
+}
+
+func (list *MoveList) clear() {
+	list.count = 0
+}
+
+func knightSet(bb uint64) uint64 {
+	var moves uint64 = moveNorth(moveNEast(bb) | moveNWest(bb))
+	moves |= moveEast(moveNEast(bb) | moveSEast(bb))
+	moves |= moveWest(moveNWest(bb) | moveSWest(bb))
+	moves |= moveSouth(moveSEast(bb) | moveSWest(bb))
+	return moves
+}
+
+func kingSet(bb uint64) uint64 {
+	var moves uint64 = moveNorth(bb) | moveSouth(bb)
+	moves |= moveEast(bb) | moveWest(bb)
+	moves |= moveNEast(bb) | moveNWest(bb)
+	moves |= moveSEast(bb) | moveSWest(bb)
+	return moves
+}
+
+func pawnAttackSet(bb uint64, color Color) uint64 {
+	if color == WHITE {
+		return moveNEast(bb) | moveNWest(bb)
+	}
+	return moveSEast(bb) | moveSWest(bb)
+}
+
+// Returns pieces of either color attacking sqr, given the occupied squares
+func (board *Board) attackersTo(sqr uint8, occupied uint64) uint64 {
+	var bb uint64 = 1 << sqr
+	var diag uint64 = board.piece[BISHOP] | board.piece[QUEEN]
+	var trans uint64 = board.piece[ROOK] | board.piece[QUEEN]
+
+	var attackers uint64 = knightSet(bb) & board.piece[KNIGHT]
+	attackers |= kingSet(bb) & board.piece[KING]
+	attackers |= bishopAttacks(sqr, occupied) & diag
+	attackers |= rookAttacks(sqr, occupied) & trans
+	attackers |= pawnAttackSet(bb, BLACK) & board.getBB(PAWN, WHITE)
+	attackers |= pawnAttackSet(bb, WHITE) & board.getBB(PAWN, BLACK)
+	return attackers
+}
+
+// Returns castling rights after a move touching the given squares. Moving
+// a king or rook, or capturing a rook, removes the matching rights.
+func updateCastleRights(castle [2]uint8, sqrs uint64) [2]uint8 {
+	for color := WHITE; color <= BLACK; color++ {
+		var rank uint8 = 56 * uint8(color)
+		if (sqrs & (0x08 << rank)) != 0 {
+			castle[color] = 0
+		}
+		if (sqrs & (0x80 << rank)) != 0 {
+			castle[color] &= ^Q_CASTLE_MASK
+		}
+		if (sqrs & (0x01 << rank)) != 0 {
+			castle[color] &= ^K_CASTLE_MASK
+		}
+	}
+	return castle
+}
+
+func (game *Game) addMove(list *MoveList, flag Flag, piece Piece,
+						  from uint64, to uint64, captured Piece, promo Piece) {
+	list.moves[list.count] = newMove(flag, piece, game.turn,
+									 bitScanForward(from), bitScanForward(to),
+									 captured, promo)
+	list.count++
+}
+
+// Adds a move for each target square in set, capturing where occupied
+func (game *Game) addSetMoves(list *MoveList, piece Piece, from uint64,
+							  set uint64) {
+	for set != 0 {
+		var to uint64 = 1 << bitScanForward(set)
+		var captured Piece = game.board.findPiece(to)
+		if captured == EMPTY {
+			game.addMove(list, QUIET, piece, from, to, EMPTY, EMPTY)
+		} else {
+			game.addMove(list, CAPTURE, piece, from, to, captured, EMPTY)
+		}
+		set ^= to
+	}
+}
+
+func (game *Game) addPawnMoves(list *MoveList, from uint64, set uint64) {
+	for set != 0 {
+		var to uint64 = 1 << bitScanForward(set)
+		var captured Piece = game.board.findPiece(to)
+
+		if (to & EIGTH_RANK) != 0 {
+			for _, promo := range [4]Piece{QUEEN, ROOK, BISHOP, KNIGHT} {
+				game.addMove(list, PROMOTION, PAWN, from, to, captured, promo)
+			}
+		} else if captured != EMPTY {
+			game.addMove(list, CAPTURE, PAWN, from, to, captured, EMPTY)
+		} else {
+			game.addMove(list, QUIET, PAWN, from, to, EMPTY, EMPTY)
+		}
+		set ^= to
+	}
+}
+
+// Fills list with every legal move for the side to move. Checking pieces
+// and pins are found up front, so no move has to be made to test legality.
+func (game *Game) generateMoves(list *MoveList) {
+	game.generate(list, GEN_ALL)
+}
+
+// Fills list with the legal moves of the given type for the side to move
+func (game *Game) generate(list *MoveList, gen genType) {
+	list.clear()
+
+	var board *Board = game.board
+	var color Color = game.turn
+	var own uint64 = board.color[color]
+	var enemy uint64 = board.color[oppColor[color]]
+	var occupied uint64 = own | enemy
+	var king uint64 = board.getBB(KING, color)
+	var kingSqr uint8 = bitScanForward(king)
+
+	var checkers uint64 = board.attackersTo(kingSqr, occupied) & enemy
+
+	// Squares pieces may move to, and pawns, which also promote on a push
+	var targets uint64 = ^own
+	var pawnTargets uint64 = ^uint64(0)
+	if gen == GEN_TACTICAL {
+		targets = enemy
+		pawnTargets = enemy | EIGTH_RANK
+	} else if gen == GEN_QUIET {
+		targets = ^occupied
+		pawnTargets = ^(enemy | EIGTH_RANK)
+	}
+
+	// King moves are checked against attacks with the king lifted, so it
+	// cannot step back along a checking ray
+	var kingMoves uint64 = kingSet(king) & targets
+	for set := kingMoves; set != 0; set &= set - 1 {
+		var sqr uint8 = bitScanForward(set)
+		if (board.attackersTo(sqr, occupied ^ king) & enemy) != 0 {
+			kingMoves ^= 1 << sqr
+		}
+	}
+	game.addSetMoves(list, KING, king, kingMoves)
+
+	// Only king moves escape double check
+	if (checkers & (checkers - 1)) != 0 {
+		return
+	}
+
+	// Other moves must capture the checker or block its ray
+	var checkMask uint64 = ^uint64(0)
+	if checkers != 0 {
+		checkMask = checkers | betweenSqrs[kingSqr][bitScanForward(checkers)]
+	}
+
+	// Pieces pinned to the king may only move along the pinning line
+	var pinned uint64 = 0
+	var snipers uint64 = (rookAttacks(kingSqr, 0) &
+						  (board.piece[ROOK] | board.piece[QUEEN]) & enemy) |
+						 (bishopAttacks(kingSqr, 0) &
+						  (board.piece[BISHOP] | board.piece[QUEEN]) & enemy)
+	for ; snipers != 0; snipers &= snipers - 1 {
+		var blockers uint64 = betweenSqrs[kingSqr][bitScanForward(snipers)] & occupied
+		if (blockers != 0) && ((blockers & (blockers - 1)) == 0) {
+			pinned |= blockers & own
+		}
+	}
+
+	for piece := QUEEN; piece <= PAWN; piece++ {
+		for pieces := board.getBB(piece, color); pieces != 0; pieces &= pieces - 1 {
+			var sqr uint8 = bitScanForward(pieces)
+			var from uint64 = 1 << sqr
+
+			var allowed uint64 = checkMask
+			if (pinned & from) != 0 {
+				allowed &= lineSqrs[kingSqr][sqr]
+			}
+
+			switch piece {
+			case QUEEN:
+				var set uint64 = rookAttacks(sqr, occupied) | bishopAttacks(sqr, occupied)
+				game.addSetMoves(list, piece, from, set & targets & allowed)
+			case ROOK:
+				game.addSetMoves(list, piece, from,
+								 rookAttacks(sqr, occupied) & targets & allowed)
+			case BISHOP:
+				game.addSetMoves(list, piece, from,
+								 bishopAttacks(sqr, occupied) & targets & allowed)
+			case KNIGHT:
+				game.addSetMoves(list, piece, from,
+								 knightSet(from) & targets & allowed)
+			case PAWN:
+				var set uint64 = pawnAttackSet(from, color) & enemy
+				if color == WHITE {
+					var push uint64 = moveNorth(from) & (^occupied)
+					set |= push | (moveNorth(push) & (^occupied) & (0xFF << 24))
+				} else {
+					var push uint64 = moveSouth(from) & (^occupied)
+					set |= push | (moveSouth(push) & (^occupied) & (0xFF << 32))
+				}
+				game.addPawnMoves(list, from, set & allowed & pawnTargets)
+				if gen != GEN_QUIET {
+					game.addEPCapture(list, from, checkers, kingSqr)
+				}
+			}
+		}
+	}
+
+	if (checkers == 0) && (gen != GEN_TACTICAL) {
+		if board.canCastleKingSide(color) {
+			game.addMove(list, K_CASTLE, KING, king, king >> 2, EMPTY, EMPTY)
+		}
+		if board.canCastleQueenSide(color) {
+			game.addMove(list, Q_CASTLE, KING, king, king << 2, EMPTY, EMPTY)
+		}
+	}
+}
+
+func (game *Game) addEPCapture(list *MoveList, from uint64, checkers uint64,
+							   kingSqr uint8) {
+	var board *Board = game.board
+	var target uint64 = board.ep & board.piece[EMPTY]
+	var captured uint64 = board.ep & board.piece[PAWN]
+	if (target == 0) || ((pawnAttackSet(from, game.turn) & target) == 0) {
+		return
+	}
+
+	// Must remove the checker or block it, and removing both pawns from
+	// the rank must not expose the king to a slider
+	if (checkers != 0) && ((checkers & captured) == 0) &&
+	   ((betweenSqrs[kingSqr][bitScanForward(checkers)] & target) == 0) {
+		return
+	}
+
+	var enemy uint64 = board.color[oppColor[game.turn]]
+	var occupied uint64 = (^board.piece[EMPTY] ^ from ^ captured) | target
+	var trans uint64 = (board.piece[ROOK] | board.piece[QUEEN]) & enemy
+	var diag uint64 = (board.piece[BISHOP] | board.piece[QUEEN]) & enemy
+	if ((rookAttacks(kingSqr, occupied) & trans) != 0) ||
+	   ((bishopAttacks(kingSqr, occupied) & diag) != 0) {
+		return
+	}
+
+	game.addMove(list, EP_CAPTURE, PAWN, from, target, PAWN, EMPTY)
+}
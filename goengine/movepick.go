@@ -0,0 +1,264 @@
+package goengine
+
+// Bound on history scores either way. Each update shrinks a score in
+// proportion to its size, keeping recent cutoffs weighted over old ones.
+const MAX_HISTORY = 1 << 14
+
+// Captures are tried ahead of promotions that capture nothing
+const CAPTURE_BONUS = 1 << 16
+
+// Stages of the move picker, in the order moves are handed out
+type pickStage uint8
+const (
+	STAGE_HASH pickStage = iota
+	STAGE_INIT_CAPTURES
+	STAGE_GOOD_CAPTURES
+	STAGE_INIT_QUIETS
+	STAGE_KILLERS
+	STAGE_QUIETS
+	STAGE_BAD_CAPTURES
+	STAGE_DONE
+)
+
+// Hands out moves best first, generating each group of moves only once
+// the earlier ones have failed to cause a cutoff: the hash move, captures
+// and promotions that do not lose material, killers and the countermove,
+// the other quiet moves by history, and captures that lose material last
+type movePicker struct {
+	game *Game
+	state *searchState
+	stage pickStage
+	// Only hand out captures and promotions that do not lose material
+	tacticalOnly bool
+
+	hashMove Move
+	// Killers for the ply, then the countermove to the last move played
+	refutations [3]Move
+	refuted int
+
+	tactical MoveList
+	quiets MoveList
+	generated [2]bool
+	scores [MAX_MOVES]int
+	index int
+
+	bad [MAX_MOVES]Move
+	badCount int
+	badIndex int
+}
+
+func (picker *movePicker) init(game *Game, state *searchState, ply int,
+							   hashMove Move) {
+	picker.game = game
+	picker.state = state
+	picker.stage = STAGE_HASH
+	picker.hashMove = hashMove
+
+	picker.refutations[0] = state.killers[ply][0]
+	picker.refutations[1] = state.killers[ply][1]
+	picker.refutations[2] = NO_MOVE
+	if last := game.lastMove(); last != NO_MOVE {
+		picker.refutations[2] = state.counters[last.Color()][last.Piece()][last.To()]
+	}
+}
+
+// Returns the next move to search, or NO_MOVE once every move has been
+// handed out
+func (picker *movePicker) next() Move {
+	for {
+		switch picker.stage {
+		case STAGE_HASH:
+			picker.stage = STAGE_INIT_CAPTURES
+			if picker.isLegal(picker.hashMove) {
+				return picker.hashMove
+			}
+		case STAGE_INIT_CAPTURES:
+			picker.generate(GEN_TACTICAL)
+			for i := 0; i < picker.tactical.count; i++ {
+				picker.scores[i] = tacticalScore(picker.tactical.moves[i])
+			}
+			picker.index = 0
+			picker.stage = STAGE_GOOD_CAPTURES
+		case STAGE_GOOD_CAPTURES:
+			if picker.index >= picker.tactical.count {
+				picker.stage = STAGE_INIT_QUIETS
+				if picker.tacticalOnly {
+					picker.stage = STAGE_DONE
+				}
+				continue
+			}
+
+			var move Move = pickBest(&picker.tactical, &picker.scores, picker.index)
+			picker.index++
+			if move == picker.hashMove {
+				continue
+			} else if picker.game.SEE(move) < 0 {
+				if !picker.tacticalOnly {
+					picker.bad[picker.badCount] = move
+					picker.badCount++
+				}
+				continue
+			}
+			return move
+		case STAGE_INIT_QUIETS:
+			picker.generate(GEN_QUIET)
+			picker.refuted = 0
+			picker.stage = STAGE_KILLERS
+		case STAGE_KILLERS:
+			if picker.refuted >= len(picker.refutations) {
+				picker.scoreQuiets()
+				picker.index = 0
+				picker.stage = STAGE_QUIETS
+				continue
+			}
+
+			var move Move = picker.refutations[picker.refuted]
+			picker.refuted++
+			if !picker.isRefutation(move, picker.refuted - 1) && picker.isLegal(move) {
+				return move
+			}
+		case STAGE_QUIETS:
+			if picker.index >= picker.quiets.count {
+				picker.stage = STAGE_BAD_CAPTURES
+				continue
+			}
+
+			var move Move = pickBest(&picker.quiets, &picker.scores, picker.index)
+			picker.index++
+			if (move != picker.hashMove) && !picker.isRefutation(move, len(picker.refutations)) {
+				return move
+			}
+		case STAGE_BAD_CAPTURES:
+			if picker.badIndex >= picker.badCount {
+				picker.stage = STAGE_DONE
+				continue
+			}
+			picker.badIndex++
+			return picker.bad[picker.badIndex - 1]
+		default:
+			return NO_MOVE
+		}
+	}
+}
+
+// Fills the list of tactical or quiet moves, unless already done to check
+// the hash move
+func (picker *movePicker) generate(gen genType) {
+	if gen == GEN_TACTICAL && !picker.generated[0] {
+		picker.game.generate(&picker.tactical, GEN_TACTICAL)
+		picker.generated[0] = true
+	} else if gen == GEN_QUIET && !picker.generated[1] {
+		picker.game.generate(&picker.quiets, GEN_QUIET)
+		picker.generated[1] = true
+	}
+}
+
+// Returns true if move is legal here, generating the moves of its type to
+// find out, as hash moves and killers may come from other positions
+func (picker *movePicker) isLegal(move Move) bool {
+	if move == NO_MOVE {
+		return false
+	}
+
+	var list *MoveList = &picker.quiets
+	if isTactical(move) {
+		picker.generate(GEN_TACTICAL)
+		list = &picker.tactical
+	} else {
+		picker.generate(GEN_QUIET)
+	}
+	for i := 0; i < list.count; i++ {
+		if list.moves[i] == move {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if move was already handed out before refutation n, as the
+// hash move or an earlier killer or countermove
+func (picker *movePicker) isRefutation(move Move, n int) bool {
+	if move == picker.hashMove {
+		return true
+	}
+	for i := 0; i < n; i++ {
+		if picker.refutations[i] == move {
+			return true
+		}
+	}
+	return false
+}
+
+func (picker *movePicker) scoreQuiets() {
+	var history *[64][64]int = &picker.state.history[picker.game.turn]
+	for i := 0; i < picker.quiets.count; i++ {
+		var move Move = picker.quiets.moves[i]
+		picker.scores[i] = history[move.From()][move.To()]
+	}
+}
+
+// Returns true for captures and promotions
+func isTactical(move Move) bool {
+	return (move.Captured() != EMPTY) || (move.Flag() == PROMOTION)
+}
+
+// Orders captures by most valuable victim, then least valuable attacker,
+// ahead of promotions
+func tacticalScore(move Move) int {
+	var score int = 0
+	if move.Captured() != EMPTY {
+		score = CAPTURE_BONUS + pieceValue[move.Captured()] * 8 -
+				pieceValue[move.Piece()] / 8
+	}
+	if move.Flag() == PROMOTION {
+		score += pieceValue[move.Promotion()]
+	}
+	return score
+}
+
+// Swaps the highest scored move from index on into index and returns it
+func pickBest(list *MoveList, scores *[MAX_MOVES]int, index int) Move {
+	var best int = index
+	for i := index + 1; i < list.count; i++ {
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+	list.moves[index], list.moves[best] = list.moves[best], list.moves[index]
+	scores[index], scores[best] = scores[best], scores[index]
+	return list.moves[index]
+}
+
+// Records a quiet move that caused a beta cutoff, and penalises the quiet
+// moves tried before it
+func (state *searchState) updateQuietStats(game *Game, move Move, ply int,
+										   depth int, tried []Move) {
+	if state.killers[ply][0] != move {
+		state.killers[ply][1] = state.killers[ply][0]
+		state.killers[ply][0] = move
+	}
+
+	if last := game.lastMove(); last != NO_MOVE {
+		state.counters[last.Color()][last.Piece()][last.To()] = move
+	}
+
+	var history *[64][64]int = &state.history[game.turn]
+	var bonus int = depth * depth
+	if bonus > MAX_HISTORY {
+		bonus = MAX_HISTORY
+	}
+	updateHistory(&history[move.From()][move.To()], bonus)
+	for _, quiet := range tried {
+		updateHistory(&history[quiet.From()][quiet.To()], -bonus)
+	}
+}
+
+// Adds bonus to a history score, less the share of it the score already
+// holds, so scores approach MAX_HISTORY or -MAX_HISTORY but never pass it
+func updateHistory(score *int, bonus int) {
+	var magnitude int = bonus
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+	*score += bonus - *score * magnitude / MAX_HISTORY
+}
@@ -0,0 +1,83 @@
+package goengine
+
+import "testing"
+
+func TestMovePicker(t *testing.T) {
+	var fens = []string{
+		START_FEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+		// In check
+		"rnbqkbnr/ppp2ppp/8/1B1pp3/4P3/8/PPPP1PPP/RNBQK1NR b KQkq - 1 3",
+	}
+
+	for _, fen := range fens {
+		game, err := FromFEN(fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var legal []Move = game.getValidMoves()
+
+		// A hash move and two killers, one of them illegal here
+		var state *searchState = new(searchState)
+		var hashMove Move = legal[len(legal) - 1]
+		state.killers[1][0] = legal[0]
+		state.killers[1][1] = newMove(QUIET, KING, game.turn, 0, 63, EMPTY, EMPTY)
+
+		var picker movePicker
+		picker.init(game, state, 1, hashMove)
+		var seen map[Move]int = make(map[Move]int)
+		var order []Move
+		for move := picker.next(); move != NO_MOVE; move = picker.next() {
+			seen[move]++
+			order = append(order, move)
+		}
+
+		if (len(order) != len(legal)) || (order[0] != hashMove) {
+			t.Errorf("%s: expected %d moves starting with %v, got: %v", fen,
+					 len(legal), hashMove, order)
+		}
+		for _, move := range legal {
+			if seen[move] != 1 {
+				t.Errorf("%s: %v handed out %d times", fen, move, seen[move])
+			}
+		}
+
+		// Captures that win material come before quiet moves, and those
+		// that lose it after
+		var quiet bool = false
+		for _, move := range order[1:] {
+			if !isTactical(move) {
+				quiet = true
+			} else if quiet && (game.SEE(move) >= 0) {
+				t.Errorf("%s: good capture %v after quiet moves", fen, move)
+			}
+		}
+	}
+}
+
+func TestHistoryBounds(t *testing.T) {
+	var game *Game = NewGame()
+	var legal []Move = game.getValidMoves()
+	var state *searchState = new(searchState)
+
+	// One move keeps causing cutoffs after the others were tried
+	var best Move = legal[0]
+	var tried []Move = legal[1:]
+	for i := 0; i < 10000; i++ {
+		state.updateQuietStats(game, best, 0, 1 + i % MAX_DEPTH, tried)
+	}
+
+	var history *[64][64]int = &state.history[WHITE]
+	var score int = history[best.From()][best.To()]
+	if (score > MAX_HISTORY) || (score < MAX_HISTORY * 9 / 10) {
+		t.Errorf("Expected %v to approach %d, got: %d", best, MAX_HISTORY, score)
+	}
+	for _, move := range tried {
+		score = history[move.From()][move.To()]
+		if (score < -MAX_HISTORY) || (score > -MAX_HISTORY * 9 / 10) {
+			t.Errorf("Expected %v to approach %d, got: %d", move, -MAX_HISTORY,
+					 score)
+		}
+	}
+}
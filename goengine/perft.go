@@ -0,0 +1,219 @@
+package goengine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A position from an EPD file along with its expected perft counts,
+// keyed by depth
+type PerftPosition struct {
+	FEN string
+	Nodes map[int]uint64
+}
+
+// Leaf nodes below a single root move
+type PerftDivide struct {
+	Move Move
+	Nodes uint64
+}
+
+// Returns the number of leaf nodes in the legal move tree of the given depth
+func (game *Game) Perft(depth int) uint64 {
+	return perft(game, depth)
+}
+
+// Returns the perft count below each legal move, in generation order
+func (game *Game) Divide(depth int) []PerftDivide {
+	if depth <= 0 {
+		return nil
+	}
+
+	// Reuse one move buffer per ply
+	var lists []MoveList = make([]MoveList, depth)
+	var list *MoveList = &lists[0]
+	game.generateMoves(list)
+
+	var divide []PerftDivide = make([]PerftDivide, list.count)
+	for i := 0; i < list.count; i++ {
+		var move Move = list.moves[i]
+		game.makeMove(move)
+		divide[i] = PerftDivide{Move: move, Nodes: perftList(game, lists[1:])}
+		game.undoMove()
+	}
+	return divide
+}
+
+func perft(game *Game, depth int) uint64 {
+	if depth <= 0 {
+		return 1
+	}
+	return perftList(game, make([]MoveList, depth))
+}
+
+func perftList(game *Game, lists []MoveList) uint64 {
+	if len(lists) == 0 {
+		return 1
+	}
+
+	var list *MoveList = &lists[0]
+	game.generateMoves(list)
+	if len(lists) == 1 {
+		return uint64(list.count)
+	}
+
+	var num uint64 = 0
+	for i := 0; i < list.count; i++ {
+		game.makeMove(list.moves[i])
+		num += perftList(game, lists[1:])
+		game.undoMove()
+	}
+
+	return num
+}
+
+// Reads perft positions in EPD form, one per line, e.g.
+// "<fen> ;D1 20 ;D2 400". Blank lines and lines starting with # are
+// skipped.
+func ReadEPD(reader io.Reader) ([]PerftPosition, error) {
+	var positions []PerftPosition
+
+	scanner := bufio.NewScanner(reader)
+	var line int = 0
+	for scanner.Scan() {
+		line++
+		var text string = strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var fields []string = strings.Split(text, ";")
+		var position PerftPosition = PerftPosition{
+			FEN   : strings.TrimSpace(fields[0]),
+			Nodes : make(map[int]uint64),
+		}
+
+		for _, field := range fields[1:] {
+			var op []string = strings.Fields(field)
+			if len(op) != 2 || !strings.HasPrefix(op[0], "D") {
+				return nil, fmt.Errorf("Line %d: %w", line, ErrInvalidEPD)
+			}
+
+			depth, err := strconv.Atoi(op[0][1:])
+			if err != nil || depth <= 0 {
+				return nil, fmt.Errorf("Line %d: %w", line, ErrInvalidEPD)
+			}
+			nodes, err := strconv.ParseUint(op[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Line %d: %w", line, ErrInvalidEPD)
+			}
+			position.Nodes[depth] = nodes
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, scanner.Err()
+}
+
+// Runs perft from the command line. Given "<depth> [fen]", prints the
+// count below each move of the position. Given "<file> [max depth]",
+// checks every position of an EPD file against its expected counts.
+func (engine *GoEngine) RunPerft(args []string, writer io.Writer) error {
+	if len(args) == 0 {
+		return ErrInvalidPerftArgs
+	}
+
+	depth, err := strconv.Atoi(args[0])
+	if err != nil {
+		var maxDepth int = MAX_DEPTH
+		if len(args) > 1 {
+			maxDepth, err = strconv.Atoi(args[1])
+			if err != nil {
+				return ErrInvalidPerftArgs
+			}
+		}
+		return runPerftSuite(args[0], maxDepth, writer)
+	}
+
+	var fen string = START_FEN
+	if len(args) > 1 {
+		fen = strings.Join(args[1:], " ")
+	}
+	game, err := FromFEN(fen)
+	if err != nil {
+		return err
+	}
+
+	var start time.Time = time.Now()
+	var total uint64 = 0
+	for _, entry := range game.Divide(depth) {
+		fmt.Fprintf(writer, "%s: %d\n", entry.Move, entry.Nodes)
+		total += entry.Nodes
+	}
+	var elapsed time.Duration = time.Since(start)
+
+	fmt.Fprintf(writer, "\nNodes: %d\n", total)
+	fmt.Fprintf(writer, "Time: %s\n", elapsed.Round(time.Millisecond))
+	fmt.Fprintf(writer, "NPS: %d\n", nodesPerSecond(total, elapsed))
+	return nil
+}
+
+func runPerftSuite(fileName string, maxDepth int, writer io.Writer) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	positions, err := ReadEPD(file)
+	if err != nil {
+		return err
+	}
+
+	var failed int = 0
+	for i, position := range positions {
+		game, err := FromFEN(position.FEN)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(writer, "%d: %s\n", i + 1, position.FEN)
+		for depth := 1; depth <= maxDepth; depth++ {
+			expected, ok := position.Nodes[depth]
+			if !ok {
+				continue
+			}
+
+			var start time.Time = time.Now()
+			var nodes uint64 = perft(game, depth)
+			var elapsed time.Duration = time.Since(start)
+
+			var result string = "ok"
+			if nodes != expected {
+				result = fmt.Sprintf("FAILED, expected %d", expected)
+				failed++
+			}
+			fmt.Fprintf(writer, "   D%d %d nodes in %s (%d nps) %s\n", depth,
+						nodes, elapsed.Round(time.Millisecond),
+						nodesPerSecond(nodes, elapsed), result)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d perft counts did not match: %w", failed,
+						  ErrPerftMismatch)
+	}
+	return nil
+}
+
+func nodesPerSecond(nodes uint64, elapsed time.Duration) uint64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return uint64(float64(nodes) / elapsed.Seconds())
+}
@@ -2,74 +2,616 @@ package goengine
 
 import (
 	"bufio"
-	"fmt"
-	"regexp"
-	"os"
+	"errors"
 	"io"
+	"strconv"
+	"strings"
 )
 
-func scanGames(fileName string, numGames int) []Game {
-	var games []Game
+// A tag pair from a game's header, e.g. [White "Carlsen, Magnus"]
+type PGNTag struct {
+	Name string
+	Value string
+}
 
-	file, err := os.Open(fileName)
-    if err != nil {
+// A move in a game tree. The first child continues the line the node is
+// on, any others are variations replacing that continuation.
+type PGNNode struct {
+	Move Move
+	NAGs []int
+	// Comments following the move
+	Comments []string
+	// Comments before the first move of a variation
+	StartingComments []string
+	Parent *PGNNode
+	Children []*PGNNode
+}
+
+// A game read from PGN. Root holds no move; it stands for the starting
+// position and carries any comment before the first move.
+type PGNGame struct {
+	Tags []PGNTag
+	Root *PGNNode
+	Result string
+	// Byte offset of the game in the uncompressed stream
+	Offset int64
+}
+
+// Suffix annotations and the NAGs they stand for
+var suffixToNAG = map[string]int {
+	"!"  : 1,
+	"?"  : 2,
+	"!!" : 3,
+	"??" : 4,
+	"!?" : 5,
+	"?!" : 6,
+}
+
+// Returns the value of the named tag, or an empty string if it is missing
+func (pgn *PGNGame) Tag(name string) string {
+	for _, tag := range pgn.Tags {
+		if tag.Name == name {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+// Returns the moves of the main line
+func (pgn *PGNGame) MainLine() []Move {
+	var moves []Move
+	for node := pgn.Root.Next(); node != nil; node = node.Next() {
+		moves = append(moves, node.Move)
+	}
+	return moves
+}
+
+// Returns a game at the starting position given by the tags, which is the
+// standard one unless a FEN tag is present
+func (pgn *PGNGame) StartingGame() (*Game, error) {
+	var fen string = pgn.Tag("FEN")
+	if fen == "" {
+		return NewGame(), nil
+	}
+	return FromFEN(fen)
+}
+
+// Returns a game with the main line played out and its result and
+// termination recorded
+func (pgn *PGNGame) Game() (*Game, error) {
+	game, err := pgn.StartingGame()
+	if err != nil {
+		return nil, err
+	}
+	for _, move := range pgn.MainLine() {
+		game.makeMove(move)
+	}
+	game.setPGNResult(pgn.Result, pgn.Tag("Termination"))
+	return game, nil
+}
+
+// Returns the node continuing the current line, or nil at its end
+func (node *PGNNode) Next() *PGNNode {
+	if len(node.Children) == 0 {
 		return nil
-    }
-	defer file.Close()
-
-	reader := bufio.NewReader(file)
-	var line string
-    for i := 0; i < numGames; i++ {
-		// PGN Header
-		var header map[string]string = make(map[string]string)
-		keyRE := regexp.MustCompile(`\[(\w)+`)
-		valueRE := regexp.MustCompile(`"[^"]+`)
-		for {
-			line, err = reader.ReadString('\n')
-			if (err != nil && err != io.EOF) ||
-			    line == "\r\n" {
-				break
+	}
+	return node.Children[0]
+}
+
+func (node *PGNNode) addChild(move Move) *PGNNode {
+	var child *PGNNode = &PGNNode{Move: move, Parent: node}
+	node.Children = append(node.Children, child)
+	return child
+}
+
+type pgnTokenType uint8
+const (
+	PGN_EOF pgnTokenType = iota
+	PGN_SYMBOL
+	PGN_STRING
+	PGN_NAG
+	PGN_COMMENT
+	PGN_PERIOD
+	PGN_TAG_OPEN
+	PGN_TAG_CLOSE
+	PGN_VAR_OPEN
+	PGN_VAR_CLOSE
+)
+
+type pgnToken struct {
+	kind pgnTokenType
+	text string
+	line int
+	column int
+	offset int64
+}
+
+// Splits PGN into tokens, tracking the line, column and byte offset of
+// each
+type pgnLexer struct {
+	reader *bufio.Reader
+	line int
+	column int
+	offset int64
+
+	// Position before the last rune read, restored when it is unread
+	lastColumn int
+	lastSize int
+
+	peeked bool
+	token pgnToken
+	err error
+}
+
+func newPGNLexer(reader io.Reader) *pgnLexer {
+	return &pgnLexer{
+		reader : bufio.NewReader(reader),
+		line   : 1,
+		column : 1,
+	}
+}
+
+func (lexer *pgnLexer) readRune() (rune, error) {
+	char, size, err := lexer.reader.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+
+	lexer.lastColumn = lexer.column
+	lexer.lastSize = size
+	lexer.offset += int64(size)
+	if char == '\n' {
+		lexer.line++
+		lexer.column = 1
+	} else {
+		lexer.column++
+	}
+	return char, nil
+}
+
+func (lexer *pgnLexer) unreadRune() {
+	lexer.reader.UnreadRune()
+	lexer.offset -= int64(lexer.lastSize)
+	if lexer.column == 1 {
+		lexer.line--
+	}
+	lexer.column = lexer.lastColumn
+}
+
+func (lexer *pgnLexer) errorAt(token pgnToken, err error) error {
+	return &PGNError{Line: token.line, Column: token.column,
+					 Offset: token.offset, Err: err}
+}
+
+// Returns the next token without consuming it
+func (lexer *pgnLexer) peek() (pgnToken, error) {
+	if !lexer.peeked {
+		lexer.token, lexer.err = lexer.scan()
+		lexer.peeked = true
+	}
+	return lexer.token, lexer.err
+}
+
+func (lexer *pgnLexer) next() (pgnToken, error) {
+	token, err := lexer.peek()
+	lexer.peeked = false
+	return token, err
+}
+
+func (lexer *pgnLexer) scan() (pgnToken, error) {
+	var char rune
+	var err error
+	for {
+		char, err = lexer.readRune()
+		if err == io.EOF {
+			return pgnToken{kind: PGN_EOF, line: lexer.line,
+							column: lexer.column, offset: lexer.offset}, nil
+		} else if err != nil {
+			return pgnToken{}, err
+		}
+
+		// A percent sign in the first column escapes the rest of the line
+		if (char == '%') && (lexer.column == 2) {
+			lexer.skipLine()
+		} else if !isPGNSpace(char) {
+			break
+		}
+	}
+
+	var token pgnToken = pgnToken{
+		line   : lexer.line,
+		column : lexer.column - 1,
+		offset : lexer.offset - int64(lexer.lastSize),
+	}
+
+	switch {
+	case char == '[':
+		token.kind = PGN_TAG_OPEN
+	case char == ']':
+		token.kind = PGN_TAG_CLOSE
+	case char == '(':
+		token.kind = PGN_VAR_OPEN
+	case char == ')':
+		token.kind = PGN_VAR_CLOSE
+	case char == '.':
+		token.kind = PGN_PERIOD
+	case char == '"':
+		token.kind = PGN_STRING
+		token.text, err = lexer.scanString()
+	case char == '{':
+		token.kind = PGN_COMMENT
+		token.text, err = lexer.scanUntil('}')
+		if err == io.EOF {
+			err = ErrUnterminatedComment
+		}
+	case char == ';':
+		token.kind = PGN_COMMENT
+		token.text = lexer.skipLine()
+	case char == '$':
+		token.kind = PGN_NAG
+		token.text = lexer.scanWhile(isDigit)
+		if token.text == "" {
+			err = ErrUnexpectedCharacter
+		}
+	case char == '*':
+		token.kind = PGN_SYMBOL
+		token.text = "*"
+	case isSymbolStart(char):
+		lexer.unreadRune()
+		token.kind = PGN_SYMBOL
+		token.text = lexer.scanWhile(isSymbolChar)
+	default:
+		err = ErrUnexpectedCharacter
+	}
+
+	if err != nil {
+		return token, lexer.errorAt(token, err)
+	}
+	return token, nil
+}
+
+// Reads a tag value, unescaping \" and \\
+func (lexer *pgnLexer) scanString() (string, error) {
+	var builder strings.Builder
+	for {
+		char, err := lexer.readRune()
+		if err != nil {
+			return "", ErrUnterminatedString
+		}
+
+		switch char {
+		case '"':
+			return builder.String(), nil
+		case '\\':
+			char, err = lexer.readRune()
+			if err != nil {
+				return "", ErrUnterminatedString
 			}
-			header[keyRE.FindString(line)[1:]] = valueRE.FindString(line)[1:]
+		case '\n':
+			return "", ErrUnterminatedString
 		}
+		builder.WriteRune(char)
+	}
+}
 
+func (lexer *pgnLexer) scanUntil(end rune) (string, error) {
+	var builder strings.Builder
+	for {
+		char, err := lexer.readRune()
 		if err != nil {
-			break
+			return strings.TrimSpace(builder.String()), err
+		} else if char == end {
+			return strings.TrimSpace(builder.String()), nil
+		}
+		builder.WriteRune(char)
+	}
+}
+
+func (lexer *pgnLexer) scanWhile(accept func(rune) bool) string {
+	var builder strings.Builder
+	for {
+		char, err := lexer.readRune()
+		if err != nil {
+			return builder.String()
+		} else if !accept(char) {
+			lexer.unreadRune()
+			return builder.String()
+		}
+		builder.WriteRune(char)
+	}
+}
+
+// Consumes the rest of the line and returns it
+func (lexer *pgnLexer) skipLine() string {
+	text, _ := lexer.scanUntil('\n')
+	return text
+}
+
+func isPGNSpace(char rune) bool {
+	return (char == ' ') || (char == '\t') || (char == '\r') || (char == '\n')
+}
+
+func isDigit(char rune) bool {
+	return (char >= '0') && (char <= '9')
+}
+
+func isSymbolStart(char rune) bool {
+	return isDigit(char) || ((char >= 'a') && (char <= 'z')) ||
+		   ((char >= 'A') && (char <= 'Z'))
+}
+
+// Symbols cover moves with their suffix annotations, move numbers, tag
+// names and results
+func isSymbolChar(char rune) bool {
+	return isSymbolStart(char) || strings.ContainsRune("_+#=:-/!?", char)
+}
+
+func isResult(text string) bool {
+	return (text == "1-0") || (text == "0-1") || (text == "1/2-1/2") ||
+		   (text == "*")
+}
+
+func isMoveNumber(text string) bool {
+	for _, char := range text {
+		if !isDigit(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reads games one at a time from PGN text, holding only the game being
+// read in memory
+type PGNParser struct {
+	lexer *pgnLexer
+	// Decompressors and files to close when done
+	closers []io.Closer
+	err error
+}
+
+// Returns a parser reading from the start of reader, which may be
+// compressed with gzip, bzip2 or zstd. An unreadable stream is reported by
+// the first call to Next.
+func NewPGNParser(reader io.Reader) *PGNParser {
+	var parser *PGNParser = &PGNParser{}
+	decompressed, err := parser.decompress(reader)
+	if err != nil {
+		parser.err = err
+		decompressed = reader
+	}
+	parser.lexer = newPGNLexer(decompressed)
+	return parser
+}
+
+// Reads every game, stopping at the first error
+func ParsePGN(reader io.Reader) ([]*PGNGame, error) {
+	var games []*PGNGame
+	var parser *PGNParser = NewPGNParser(reader)
+	for {
+		pgn, err := parser.Next()
+		if err == io.EOF {
+			return games, nil
+		} else if err != nil {
+			return games, err
+		}
+		games = append(games, pgn)
+	}
+}
+
+// Returns the next game, or io.EOF when there are none left. After an
+// error the rest of the bad game is skipped, so reading can continue.
+func (parser *PGNParser) Next() (*PGNGame, error) {
+	if parser.err != nil {
+		return nil, parser.err
+	}
+
+	var pgn *PGNGame = &PGNGame{Root: &PGNNode{}, Result: "*",
+								Offset: parser.Offset()}
+	err := parser.parseTags(pgn)
+	if err != nil {
+		parser.skipGame(false)
+		return nil, err
+	}
+
+	token, err := parser.lexer.peek()
+	if err != nil {
+		parser.skipGame(false)
+		return nil, err
+	} else if (token.kind == PGN_EOF) && (len(pgn.Tags) == 0) {
+		return nil, io.EOF
+	}
+
+	game, err := pgn.StartingGame()
+	if err != nil {
+		parser.skipGame(true)
+		return nil, parser.lexer.errorAt(token, err)
+	}
+
+	err = parser.parseLine(pgn, pgn.Root, game, false)
+	if err != nil {
+		parser.skipGame(true)
+		return nil, err
+	}
+	return pgn, nil
+}
+
+func (parser *PGNParser) parseTags(pgn *PGNGame) error {
+	for {
+		token, err := parser.lexer.peek()
+		if err != nil {
+			return err
+		} else if token.kind != PGN_TAG_OPEN {
+			return nil
+		}
+		parser.lexer.next()
+
+		name, err := parser.expect(PGN_SYMBOL)
+		if err != nil {
+			return err
+		}
+		value, err := parser.expect(PGN_STRING)
+		if err != nil {
+			return err
+		}
+		_, err = parser.expect(PGN_TAG_CLOSE)
+		if err != nil {
+			return err
 		}
+		pgn.Tags = append(pgn.Tags, PGNTag{Name: name.text, Value: value.text})
+	}
+}
+
+func (parser *PGNParser) expect(kind pgnTokenType) (pgnToken, error) {
+	token, err := parser.lexer.next()
+	if err != nil {
+		return token, err
+	} else if token.kind != kind {
+		return token, parser.lexer.errorAt(token, ErrUnexpectedToken)
+	}
+	return token, nil
+}
 
-		// PGN Move Data
-		var data string = ""
-		for {
-			line, err = reader.ReadString('\n')
-			if (err != nil && err != io.EOF) ||
-			    line == "\r\n" {
-				break
+// Reads moves following start, with game at start's position, until the
+// result, the end of a variation or the next game. Variations are read
+// recursively, and take back their moves before returning.
+func (parser *PGNParser) parseLine(pgn *PGNGame, start *PGNNode, game *Game,
+								   nested bool) error {
+	var node *PGNNode = start
+	var pending []string
+	var made int = 0
+	defer func() {
+		if nested {
+			for ; made > 0; made-- {
+				game.undoMove()
 			}
-			data += line
 		}
+	}()
 
-		// Setup Game struct
-		var game Game = Game{}
-		game.setup()
-		game.setGameStatus(header["Result"])
+	for {
+		token, err := parser.lexer.peek()
+		if err != nil {
+			return err
+		}
+
+		switch token.kind {
+		case PGN_EOF, PGN_TAG_OPEN:
+			// Games may end without a result before the next one begins
+			if nested {
+				return parser.lexer.errorAt(token, ErrUnterminatedVariation)
+			}
+			return nil
+		case PGN_VAR_CLOSE:
+			parser.lexer.next()
+			if !nested {
+				return parser.lexer.errorAt(token, ErrUnexpectedToken)
+			}
+			return nil
+		case PGN_PERIOD:
+			parser.lexer.next()
+		case PGN_COMMENT:
+			parser.lexer.next()
+			if nested && (node == start) {
+				pending = append(pending, token.text)
+			} else {
+				node.Comments = append(node.Comments, token.text)
+			}
+		case PGN_NAG:
+			parser.lexer.next()
+			nag, _ := strconv.Atoi(token.text)
+			node.NAGs = append(node.NAGs, nag)
+		case PGN_VAR_OPEN:
+			parser.lexer.next()
+			if node == start {
+				return parser.lexer.errorAt(token, ErrUnexpectedToken)
+			}
 
-		// Get list of moves in an algebraic format
-		moveRE := regexp.MustCompile(`[A-Za-z][\w-]+[\+]?`)
-		var moves []string = moveRE.FindAllString(data, -1)
+			// A variation replaces the move just read
+			game.undoMove()
+			err = parser.parseLine(pgn, node.Parent, game, true)
+			if err != nil {
+				return err
+			}
+			game.makeMove(node.Move)
+		case PGN_SYMBOL:
+			parser.lexer.next()
+			if isResult(token.text) {
+				if nested {
+					return parser.lexer.errorAt(token, ErrUnterminatedVariation)
+				}
+				pgn.Result = token.text
+				return nil
+			} else if isMoveNumber(token.text) {
+				continue
+			}
 
-		// Add each move into create Game struct
-		var err error
-		for _, cmd := range moves {
-			err = game.pushSAN(cmd)
+			var san string = strings.TrimRight(token.text, "!?")
+			move, err := game.ParseMove(san)
 			if err != nil {
-				fmt.Println(cmd)
-				fmt.Println(err)
-				break
+				return parser.lexer.errorAt(token, err)
+			}
+
+			node = node.addChild(move)
+			node.StartingComments = pending
+			pending = nil
+			if nag, ok := suffixToNAG[token.text[len(san):]]; ok {
+				node.NAGs = append(node.NAGs, nag)
 			}
+			game.makeMove(move)
+			made++
+		default:
+			parser.lexer.next()
+			return parser.lexer.errorAt(token, ErrUnexpectedToken)
 		}
+	}
+}
+
+// Skips the rest of a game after an error. Once in the movetext, a tag
+// can only belong to the next game.
+func (parser *PGNParser) skipGame(inMoves bool) {
+	for {
+		token, err := parser.lexer.peek()
+		if _, ok := err.(*PGNError); (err != nil) && !ok {
+			// Reading failed, so there is nothing left to skip
+			return
+		} else if (err == nil) && (token.kind == PGN_EOF) {
+			return
+		} else if (err == nil) && inMoves && (token.kind == PGN_TAG_OPEN) {
+			return
+		}
+
+		parser.lexer.next()
+		if (err == nil) && (token.kind == PGN_SYMBOL) && isResult(token.text) {
+			return
+		}
+	}
+}
 
+// Replays up to numGames games from a PGN file, skipping malformed ones
+func scanGames(fileName string, numGames int) ([]*Game, error) {
+	var games []*Game
+
+	parser, err := OpenPGN(fileName, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+
+	for len(games) < numGames {
+		pgn, err := parser.Next()
+		if err == io.EOF {
+			break
+		} else if errors.As(err, new(*PGNError)) {
+			continue
+		} else if err != nil {
+			return games, err
+		}
+
+		game, err := pgn.Game()
+		if err != nil {
+			continue
+		}
 		games = append(games, game)
 	}
-	
-	return games
-}
\ No newline at end of file
+
+	return games, nil
+}
@@ -0,0 +1,224 @@
+package goengine
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Longest movetext line, so export format PGN fits in 80 columns
+const PGN_LINE_LENGTH = 79
+
+// Tags every exported game starts with, in order, and their values when
+// unknown
+var sevenTagRoster = [7]PGNTag{
+	{Name: "Event", Value: "?"},
+	{Name: "Site", Value: "?"},
+	{Name: "Date", Value: "????.??.??"},
+	{Name: "Round", Value: "?"},
+	{Name: "White", Value: "?"},
+	{Name: "Black", Value: "?"},
+	{Name: "Result", Value: "*"},
+}
+
+var tagEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// Returns the moves played so far as a game tree with the given tags. The
+// result comes from the game, and SetUp and FEN tags are added if it did
+// not start from START_FEN.
+func (game *Game) ToPGN(tags ...PGNTag) *PGNGame {
+	var pgn *PGNGame = &PGNGame{
+		Root   : &PGNNode{},
+		Result : game.getGameStatus().String(),
+	}
+
+	for _, tag := range tags {
+		switch tag.Name {
+		case "SetUp", "FEN", "Result":
+			continue
+		}
+		pgn.Tags = append(pgn.Tags, tag)
+	}
+
+	// The initial FEN may have been given without clocks
+	start, err := FromFEN(game.initFEN)
+	if (err == nil) && (start.FEN() != START_FEN) {
+		pgn.Tags = append(pgn.Tags, PGNTag{Name: "SetUp", Value: "1"},
+						  PGNTag{Name: "FEN", Value: start.FEN()})
+	}
+
+	var node *PGNNode = pgn.Root
+	for _, move := range game.moves {
+		node = node.addChild(move)
+	}
+	return pgn
+}
+
+// Returns the game so far in PGN export format
+func (game *Game) PGN(tags ...PGNTag) string {
+	return game.ToPGN(tags...).String()
+}
+
+// Returns the game in PGN export format, or an empty string if its FEN tag
+// is invalid
+func (pgn *PGNGame) String() string {
+	var builder strings.Builder
+	pgn.WritePGN(&builder)
+	return builder.String()
+}
+
+// Writes the game in PGN export format: the Seven Tag Roster followed by
+// any other tags, then SAN movetext wrapped at 80 columns and a blank line
+func (pgn *PGNGame) WritePGN(writer io.Writer) error {
+	game, err := pgn.StartingGame()
+	if err != nil {
+		return err
+	}
+
+	var builder strings.Builder
+	for _, tag := range sevenTagRoster {
+		var value string = pgn.Tag(tag.Name)
+		if tag.Name == "Result" {
+			value = pgn.Result
+		} else if value == "" {
+			value = tag.Value
+		}
+		writeTag(&builder, tag.Name, value)
+	}
+
+	for _, tag := range pgn.Tags {
+		if isRosterTag(tag.Name) {
+			continue
+		} else if (tag.Name == "FEN") && (pgn.Tag("SetUp") == "") {
+			writeTag(&builder, "SetUp", "1")
+		}
+		writeTag(&builder, tag.Name, tag.Value)
+	}
+
+	if (pgn.Tag("ECO") == "") && (pgn.Tag("Opening") == "") {
+		if opening, ok := pgn.Opening(); ok {
+			writeTag(&builder, "ECO", opening.ECO)
+			writeTag(&builder, "Opening", opening.Name)
+		}
+	}
+	builder.WriteString("\n")
+
+	var movetext *pgnWriter = &pgnWriter{out: &builder}
+	for _, comment := range pgn.Root.Comments {
+		movetext.addComment(comment)
+	}
+	movetext.addLine(pgn.Root, game, true)
+	movetext.add(pgn.Result)
+	builder.WriteString("\n\n")
+
+	_, err = io.WriteString(writer, builder.String())
+	return err
+}
+
+func writeTag(builder *strings.Builder, name string, value string) {
+	fmt.Fprintf(builder, "[%s \"%s\"]\n", name, tagEscaper.Replace(value))
+}
+
+func isRosterTag(name string) bool {
+	for _, tag := range sevenTagRoster {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Lays out movetext tokens, starting a new line before any token that
+// would run past PGN_LINE_LENGTH
+type pgnWriter struct {
+	out *strings.Builder
+	length int
+	last string
+}
+
+// Variation parentheses hug the moves inside them
+func (writer *pgnWriter) add(token string) {
+	var space int = 1
+	if (writer.last == "(") || (token == ")") {
+		space = 0
+	}
+
+	if (writer.length > 0) &&
+	   (writer.length + space + len(token) > PGN_LINE_LENGTH) {
+		writer.out.WriteString("\n")
+		writer.length = 0
+	} else if writer.length > 0 {
+		writer.out.WriteString(strings.Repeat(" ", space))
+		writer.length += space
+	}
+	writer.out.WriteString(token)
+	writer.length += len(token)
+	writer.last = token
+}
+
+// Comments are split into words so long ones wrap too. A closing brace
+// would end the comment early, so it is dropped.
+func (writer *pgnWriter) addComment(comment string) {
+	var words []string = strings.Fields(strings.Replace(comment, "}", "", -1))
+	if len(words) == 0 {
+		writer.add("{}")
+		return
+	}
+
+	words[0] = "{" + words[0]
+	words[len(words) - 1] += "}"
+	for _, word := range words {
+		writer.add(word)
+	}
+}
+
+// Writes the moves following parent, with game at parent's position.
+// Black's moves are numbered when they begin a line or follow a comment
+// or variation.
+func (writer *pgnWriter) addLine(parent *PGNNode, game *Game, number bool) {
+	var made int = 0
+	for len(parent.Children) > 0 {
+		var node *PGNNode = parent.Children[0]
+		writer.addMove(node, game, number)
+		number = len(node.Comments) > 0
+
+		for _, variation := range parent.Children[1:] {
+			writer.add("(")
+			writer.addMove(variation, game, true)
+			game.makeMove(variation.Move)
+			writer.addLine(variation, game, len(variation.Comments) > 0)
+			game.undoMove()
+			writer.add(")")
+			number = true
+		}
+
+		game.makeMove(node.Move)
+		made++
+		parent = node
+	}
+
+	for ; made > 0; made-- {
+		game.undoMove()
+	}
+}
+
+func (writer *pgnWriter) addMove(node *PGNNode, game *Game, number bool) {
+	for _, comment := range node.StartingComments {
+		writer.addComment(comment)
+	}
+
+	if game.turn == WHITE {
+		writer.add(strconv.Itoa(int(game.fullmove)) + ".")
+	} else if number || (len(node.StartingComments) > 0) {
+		writer.add(strconv.Itoa(int(game.fullmove)) + "...")
+	}
+
+	writer.add(game.SAN(node.Move))
+	for _, nag := range node.NAGs {
+		writer.add("$" + strconv.Itoa(nag))
+	}
+	for _, comment := range node.Comments {
+		writer.addComment(comment)
+	}
+}
@@ -0,0 +1,150 @@
+package goengine
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Leading bytes of each compressed format
+var (
+	GZIP_MAGIC = []byte{0x1f, 0x8b}
+	BZIP2_MAGIC = []byte("BZh")
+	ZSTD_MAGIC = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// A game read from a stream, or the reason it could not be read
+type PGNResult struct {
+	Game *PGNGame
+	Err error
+}
+
+// Opens a PGN file, compressed or not, and skips to the given byte offset
+// in its uncompressed text, e.g. a game's Offset to resume reading from
+// it. Line numbers in errors count from the offset.
+func OpenPGN(fileName string, offset int64) (*PGNParser, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var parser *PGNParser = &PGNParser{closers: []io.Closer{file}}
+	reader, err := parser.decompress(file)
+	if err != nil {
+		parser.Close()
+		return nil, err
+	}
+
+	if offset > 0 {
+		if reader == io.Reader(file) {
+			_, err = file.Seek(offset, io.SeekStart)
+		} else {
+			// Compressed streams have to be read up to the offset
+			_, err = io.CopyN(ioutil.Discard, reader, offset)
+		}
+		if err != nil {
+			parser.Close()
+			return nil, err
+		}
+	}
+
+	parser.lexer = newPGNLexer(reader)
+	parser.lexer.offset = offset
+	return parser, nil
+}
+
+// Picks a decompressor by the stream's leading bytes
+func (parser *PGNParser) decompress(reader io.Reader) (io.Reader, error) {
+	var buffered *bufio.Reader = bufio.NewReader(reader)
+	magic, err := buffered.Peek(len(ZSTD_MAGIC))
+	if (err != nil) && (err != io.EOF) {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, GZIP_MAGIC):
+		decoder, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		parser.closers = append(parser.closers, decoder)
+		return decoder, nil
+	case bytes.HasPrefix(magic, BZIP2_MAGIC):
+		return bzip2.NewReader(buffered), nil
+	case bytes.HasPrefix(magic, ZSTD_MAGIC):
+		decoder, err := zstd.NewReader(buffered, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		parser.closers = append(parser.closers, decoder.IOReadCloser())
+		return decoder, nil
+	}
+
+	// Plain files are read directly so they can be seeked, which means
+	// giving back the bytes peeked at
+	if file, ok := reader.(*os.File); ok {
+		_, err = file.Seek(-int64(buffered.Buffered()), io.SeekCurrent)
+		if err == nil {
+			return file, nil
+		}
+	}
+	return buffered, nil
+}
+
+// Returns the byte offset in the uncompressed stream where the next game
+// starts
+func (parser *PGNParser) Offset() int64 {
+	token, err := parser.lexer.peek()
+	if err != nil {
+		return parser.lexer.offset
+	}
+	return token.offset
+}
+
+// Releases the decompressor and file behind the parser, if any
+func (parser *PGNParser) Close() error {
+	var err error
+	for i := len(parser.closers) - 1; i >= 0; i-- {
+		closeErr := parser.closers[i].Close()
+		if err == nil {
+			err = closeErr
+		}
+	}
+	parser.closers = nil
+	return err
+}
+
+// Sends each game on the returned channel as it is read, along with an
+// error for each malformed game. The channel closes at the end of the
+// stream, on a read error or when ctx is cancelled.
+func (parser *PGNParser) Games(ctx context.Context) <-chan PGNResult {
+	var results chan PGNResult = make(chan PGNResult)
+	go func() {
+		defer close(results)
+		for {
+			pgn, err := parser.Next()
+			if err == io.EOF {
+				return
+			}
+
+			select {
+			case results <- PGNResult{Game: pgn, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+
+			if (err != nil) && !errors.As(err, new(*PGNError)) {
+				return
+			}
+		}
+	}()
+	return results
+}
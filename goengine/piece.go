@@ -17,16 +17,6 @@ const (
 	BLACK
 )
 
-var pieceToPoints = map[Piece]int {
-	KING   : MAX_INT,
-	QUEEN  : 9,
-	ROOK   : 5,
-	BISHOP : 3,
-	KNIGHT : 3,
-	PAWN   : 1,
-	EMPTY  : 0,
-}
-
 var runeToPiece = map[rune]Piece {
 	'K' : KING,
 	'Q' : QUEEN,
@@ -0,0 +1,237 @@
+package goengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Games each worker may have queued or waiting to be handed back, which
+// bounds memory when one slow game holds up the rest in ordered mode
+const PIPELINE_BACKLOG = 16
+
+const DEFAULT_PROGRESS_INTERVAL = time.Second
+
+// Fans games read from PGN out to worker goroutines and hands their
+// results back in stream order, or as they finish if Unordered is set
+type Pipeline struct {
+	// Number of workers, one per CPU if not set
+	Workers int
+	Unordered bool
+	// Runs on each well-formed game, e.g. to replay it or extract
+	// features. If nil, games are only parsed.
+	Work func(pgn *PGNGame) (interface{}, error)
+	// Called every ProgressInterval, and once at the end
+	Progress func(report PipelineReport)
+	ProgressInterval time.Duration
+}
+
+// A game and what its worker returned for it
+type PipelineResult struct {
+	// Position of the game in the stream, counting from 0
+	Index int
+	Offset int64
+	// Nil if the game could not be parsed
+	Game *PGNGame
+	Value interface{}
+	Err error
+}
+
+// Reports a game that could not be parsed or that its worker failed on
+type GameError struct {
+	Index int
+	Offset int64
+	Err error
+}
+
+func (err *GameError) Error() string {
+	return fmt.Sprintf("Game %d at offset %d: %s", err.Index, err.Offset,
+					   err.Err)
+}
+
+func (err *GameError) Unwrap() error {
+	return err.Err
+}
+
+// Games handed back so far, and the errors among them
+type PipelineReport struct {
+	Games int
+	Errors []*GameError
+	Elapsed time.Duration
+}
+
+func (report PipelineReport) GamesPerSecond() float64 {
+	if report.Elapsed <= 0 {
+		return 0
+	}
+	return float64(report.Games) / report.Elapsed.Seconds()
+}
+
+// Work for a pipeline that replays each game's main line and hands back
+// the resulting *Game
+func ReplayGame(pgn *PGNGame) (interface{}, error) {
+	return pgn.Game()
+}
+
+// Reads every game from parser and passes each result to handle on the
+// calling goroutine. Malformed games are handed over with their error and
+// collected in the report. Stops early if ctx is cancelled, reading fails
+// or handle returns an error, which is then returned.
+func (pipeline *Pipeline) Run(ctx context.Context, parser *PGNParser,
+							  handle func(result PipelineResult) error) (PipelineReport, error) {
+	var workers int = pipeline.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var jobs chan PipelineResult = make(chan PipelineResult)
+	var results chan PipelineResult = make(chan PipelineResult)
+	var slots chan bool = make(chan bool, workers * PIPELINE_BACKLOG)
+	var readErr error
+
+	var wg sync.WaitGroup
+	wg.Add(workers + 1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		readErr = pipeline.read(ctx, parser, jobs, slots)
+	}()
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			pipeline.work(ctx, jobs, results)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Let the reader and workers finish before giving back the parser
+	defer func() {
+		cancel()
+		for range results {
+		}
+	}()
+
+	var report PipelineReport
+	var start time.Time = time.Now()
+	var interval time.Duration = pipeline.ProgressInterval
+	if interval <= 0 {
+		interval = DEFAULT_PROGRESS_INTERVAL
+	}
+	var tick <-chan time.Time
+	if pipeline.Progress != nil {
+		var ticker *time.Ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	var pending map[int]PipelineResult = make(map[int]PipelineResult)
+	var next int = 0
+	var err error
+	for running := true; running && (err == nil); {
+		select {
+		case result, ok := <-results:
+			if !ok {
+				running = false
+			} else if pipeline.Unordered {
+				err = pipeline.deliver(&report, result, slots, handle)
+			} else {
+				pending[result.Index] = result
+				for err == nil {
+					ready, ok := pending[next]
+					if !ok {
+						break
+					}
+					delete(pending, next)
+					next++
+					err = pipeline.deliver(&report, ready, slots, handle)
+				}
+			}
+		case <-tick:
+			report.Elapsed = time.Since(start)
+			pipeline.Progress(report)
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	if err == nil {
+		err = readErr
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	report.Elapsed = time.Since(start)
+	if pipeline.Progress != nil {
+		pipeline.Progress(report)
+	}
+	return report, err
+}
+
+// Sends games to the workers until the stream ends, taking a slot for
+// each so no more than the backlog is in flight
+func (pipeline *Pipeline) read(ctx context.Context, parser *PGNParser,
+							   jobs chan<- PipelineResult, slots chan bool) error {
+	for index := 0; ; index++ {
+		select {
+		case slots <- true:
+		case <-ctx.Done():
+			return nil
+		}
+
+		var offset int64 = parser.Offset()
+		pgn, err := parser.Next()
+		if err == io.EOF {
+			return nil
+		} else if (err != nil) && !errors.As(err, new(*PGNError)) {
+			return err
+		}
+
+		select {
+		case jobs <- PipelineResult{Index: index, Offset: offset, Game: pgn, Err: err}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (pipeline *Pipeline) work(ctx context.Context, jobs <-chan PipelineResult,
+							   results chan<- PipelineResult) {
+	for job := range jobs {
+		if (job.Err == nil) && (pipeline.Work != nil) {
+			job.Value, job.Err = pipeline.Work(job.Game)
+		}
+
+		select {
+		case results <- job:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (pipeline *Pipeline) deliver(report *PipelineReport, result PipelineResult,
+								  slots chan bool,
+								  handle func(result PipelineResult) error) error {
+	<-slots
+	report.Games++
+	if result.Err != nil {
+		report.Errors = append(report.Errors, &GameError{
+			Index  : result.Index,
+			Offset : result.Offset,
+			Err    : result.Err,
+		})
+	}
+
+	if handle == nil {
+		return nil
+	}
+	return handle(result)
+}
@@ -0,0 +1,318 @@
+package goengine
+
+import "strings"
+
+// Returns a legal move in standard algebraic notation, e.g. Nbd7, exd6,
+// e8=Q+ or O-O#
+func (game *Game) SAN(move Move) string {
+	return game.moveToSAN(move, game.getValidMoves())
+}
+
+// Renders move given every legal move in the position, which is needed
+// to disambiguate pieces of the same type
+func (game *Game) moveToSAN(move Move, legal []Move) string {
+	var san string
+	switch move.Flag() {
+	case K_CASTLE:
+		san = "O-O"
+	case Q_CASTLE:
+		san = "O-O-O"
+	default:
+		var from string = move.From().String()
+		var capture bool = move.Captured() != EMPTY
+
+		if move.Piece() == PAWN {
+			// Pawn captures are named by the file they leave
+			if capture {
+				san = from[0:1]
+			}
+		} else {
+			san = pieceToString[WHITE][move.Piece()] +
+				  game.disambiguate(move, legal)
+		}
+
+		if capture {
+			san += "x"
+		}
+		san += move.To().String()
+
+		if move.Flag() == PROMOTION {
+			san += "=" + pieceToString[WHITE][move.Promotion()]
+		}
+	}
+
+	return san + game.checkSuffix(move)
+}
+
+// Returns the least of file, rank or both needed to tell move apart from
+// other pieces of its type that can reach the same square
+func (game *Game) disambiguate(move Move, legal []Move) string {
+	var ambiguous, sameFile, sameRank bool
+	for _, other := range legal {
+		if (other.Piece() != move.Piece()) || (other.To() != move.To()) ||
+		   (other.From() == move.From()) {
+			continue
+		}
+		ambiguous = true
+		if (other.From() % 8) == (move.From() % 8) {
+			sameFile = true
+		}
+		if (other.From() / 8) == (move.From() / 8) {
+			sameRank = true
+		}
+	}
+
+	var from string = move.From().String()
+	if !ambiguous {
+		return ""
+	} else if !sameFile {
+		return from[0:1]
+	} else if !sameRank {
+		return from[1:2]
+	}
+	return from
+}
+
+// Returns "#" if move checkmates, "+" if it checks, else nothing
+func (game *Game) checkSuffix(move Move) string {
+	game.makeMove(move)
+	defer game.undoMove()
+
+	if !game.board.isKingInCheck(game.turn) {
+		return ""
+	} else if !game.hasValidMoves() {
+		return "#"
+	}
+	return "+"
+}
+
+// A move as written, before it is matched against the legal moves.
+// Fields that were left out of the notation are NO_SQUARE or EMPTY.
+type moveSpec struct {
+	castle Flag
+	piece Piece
+	fromFile int
+	fromRank int
+	to uint8
+	promo Piece
+	marked bool // Notation says whether the move captures
+	capture bool
+}
+
+// Marks a file or rank left out of the notation
+const NO_SQUARE = -1
+
+// Parses a move in standard algebraic (Nf3, exd8=Q+, O-O), long algebraic
+// (Ng1-f3, e7xd8Q) or coordinate notation (g1f3, e7d8q) and returns the
+// legal move it names. Check marks and annotation glyphs are ignored, but
+// a capture mark, or its absence outside coordinate notation, must be right.
+// Errors are *MoveError and match ErrInvalidNotation, ErrInvalidPromotion,
+// ErrCaptureMark, ErrAmbiguousMove, ErrNoMatchingPiece, ErrNoPiece, ErrOpponentPiece,
+// ErrKingInCheck, ErrCannotCastle or ErrIllegalMove with errors.Is.
+func (game *Game) ParseMove(notation string) (Move, error) {
+	spec, err := parseMoveSpec(notation)
+	if err != nil {
+		return NO_MOVE, &MoveError{Notation: notation, Err: err}
+	}
+
+	move, err := game.resolveMove(spec)
+	if err != nil {
+		return NO_MOVE, &MoveError{Notation: notation, Err: err}
+	}
+	return move, nil
+}
+
+func parseMoveSpec(notation string) (moveSpec, error) {
+	var spec moveSpec = moveSpec{
+		piece    : EMPTY,
+		fromFile : NO_SQUARE,
+		fromRank : NO_SQUARE,
+		promo    : EMPTY,
+	}
+
+	// Drop annotation glyphs, then check and mate marks
+	var str string = strings.TrimSpace(notation)
+	str = strings.TrimRight(str, "!?")
+	str = strings.TrimRight(str, "+#")
+
+	switch str {
+	case "O-O", "0-0":
+		spec.castle = K_CASTLE
+		return spec, nil
+	case "O-O-O", "0-0-0":
+		spec.castle = Q_CASTLE
+		return spec, nil
+	}
+
+	// Piece letters are uppercase so they are never confused with files
+	var lettered bool = false
+	if len(str) > 0 {
+		if piece, ok := runeToPiece[rune(str[0])]; ok {
+			spec.piece = piece
+			lettered = true
+			str = str[1:]
+		}
+	}
+	if len(str) < 2 {
+		return spec, ErrInvalidNotation
+	}
+
+	// Promotion piece follows the destination, with or without "="
+	var last byte = str[len(str) - 1]
+	if (last < '1') || (last > '8') {
+		promo, ok := runeToPiece[rune(strings.ToUpper(str[len(str) - 1:])[0])]
+		if !ok {
+			return spec, ErrInvalidNotation
+		} else if promo == KING {
+			return spec, ErrInvalidPromotion
+		}
+		spec.promo = promo
+		str = strings.TrimSuffix(str[:len(str) - 1], "=")
+		if spec.piece == EMPTY {
+			spec.piece = PAWN
+		} else if spec.piece != PAWN {
+			return spec, ErrInvalidPromotion
+		}
+	}
+
+	if len(str) < 2 {
+		return spec, ErrInvalidNotation
+	}
+	to, err := stringToSqr(str[len(str) - 2:])
+	if err != nil {
+		return spec, ErrInvalidNotation
+	}
+	spec.to = to
+	str = str[:len(str) - 2]
+
+	// A capture mark or long algebraic dash says whether a piece is taken
+	if strings.HasSuffix(str, "x") {
+		spec.marked = true
+		spec.capture = true
+		str = str[:len(str) - 1]
+	} else if strings.HasSuffix(str, "-") {
+		spec.marked = true
+		str = str[:len(str) - 1]
+	}
+
+	// Whatever is left narrows down the square the piece moves from
+	if (len(str) > 0) && (str[0] >= 'a') && (str[0] <= 'h') {
+		spec.fromFile = int(str[0] - 'a')
+		str = str[1:]
+	}
+	if (len(str) > 0) && (str[0] >= '1') && (str[0] <= '8') {
+		spec.fromRank = int(str[0] - '1')
+		str = str[1:]
+	}
+	if len(str) > 0 {
+		return spec, ErrInvalidNotation
+	}
+
+	// Only coordinate notation, which names both squares, leaves out
+	// the capture mark
+	var coordinate bool = !lettered &&
+		(spec.fromFile != NO_SQUARE) && (spec.fromRank != NO_SQUARE)
+	if !coordinate {
+		spec.marked = true
+	}
+
+	// A bare destination square is a pawn push
+	if (spec.piece == EMPTY) && !coordinate {
+		spec.piece = PAWN
+	}
+	return spec, nil
+}
+
+// Returns true if move was made by a piece on the file and rank given
+func (spec *moveSpec) matchesFrom(sqr Square) bool {
+	var file int = 7 - int(sqr % 8)
+	var rank int = int(sqr / 8)
+	return ((spec.fromFile == NO_SQUARE) || (spec.fromFile == file)) &&
+		   ((spec.fromRank == NO_SQUARE) || (spec.fromRank == rank))
+}
+
+func (game *Game) resolveMove(spec moveSpec) (Move, error) {
+	var legal []Move = game.getValidMoves()
+
+	if spec.castle != UNKNOWN {
+		for _, move := range legal {
+			if move.Flag() == spec.castle {
+				return move, nil
+			}
+		}
+		return NO_MOVE, ErrCannotCastle
+	}
+
+	var found Move = NO_MOVE
+	var matches int = 0
+	for _, move := range legal {
+		if (move.To() != Square(spec.to)) || !spec.matchesFrom(move.From()) {
+			continue
+		} else if (spec.piece != EMPTY) && (move.Piece() != spec.piece) {
+			continue
+		}
+
+		// A promotion must name its piece, and only promotions may
+		if (move.Flag() == PROMOTION) && (spec.promo == EMPTY) {
+			return NO_MOVE, ErrInvalidPromotion
+		} else if move.Promotion() != spec.promo {
+			continue
+		}
+
+		found = move
+		matches++
+	}
+
+	if matches == 1 {
+		if spec.marked && (spec.capture != (found.Captured() != EMPTY)) {
+			return NO_MOVE, ErrCaptureMark
+		}
+		return found, nil
+	} else if matches > 1 {
+		return NO_MOVE, ErrAmbiguousMove
+	}
+	return NO_MOVE, game.diagnoseMove(spec)
+}
+
+// Explains why no legal move matches spec
+func (game *Game) diagnoseMove(spec moveSpec) error {
+	var board *Board = game.board
+	var own uint64 = board.color[game.turn]
+
+	// Coordinate moves name the exact square the piece leaves
+	if (spec.fromFile != NO_SQUARE) && (spec.fromRank != NO_SQUARE) {
+		var from uint64 = 1 << uint8(spec.fromRank * 8 + (7 - spec.fromFile))
+		if (from & board.piece[EMPTY]) != 0 {
+			return ErrNoPiece
+		} else if (from & own) == 0 {
+			return ErrOpponentPiece
+		}
+	}
+
+	// Find the pieces the notation could refer to
+	var found bool = false
+	for piece := KING; piece < EMPTY; piece++ {
+		if (spec.piece != EMPTY) && (piece != spec.piece) {
+			continue
+		}
+
+		for pieces := board.getBB(piece, game.turn); pieces != 0; pieces &= pieces - 1 {
+			var sqr uint8 = bitScanForward(pieces)
+			if !spec.matchesFrom(Square(sqr)) {
+				continue
+			}
+			found = true
+
+			// The piece could reach the square if not for its own king
+			var set uint64 = board.getPieceSet(piece, 1 << sqr, game.turn)
+			if (set & (1 << spec.to)) != 0 {
+				return ErrKingInCheck
+			}
+		}
+	}
+
+	if !found {
+		return ErrNoMatchingPiece
+	}
+	return ErrIllegalMove
+}
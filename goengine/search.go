@@ -0,0 +1,669 @@
+package goengine
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+const DEFAULT_DEPTH = 5
+const MAX_DEPTH = 64
+
+// Deepest the search may reach from the root, including extensions
+const MAX_PLY = 128
+
+// Score of being checkmated at the root. Mates further away score closer
+// to zero by one per ply, so shorter mates are preferred.
+const MATE_SCORE = 31000
+const INFINITE_SCORE = 32000
+
+// Time kept in reserve so the engine never flags on lag
+const MOVE_OVERHEAD = 50 * time.Millisecond
+
+// Moves assumed left in the game when the GUI gives no moves-to-go
+const DEFAULT_MOVES_TO_GO = 30
+
+// Depth from which the root is searched in a window around the previous
+// iteration's score, and the initial width either side in centipawns
+const ASPIRATION_DEPTH = 4
+const ASPIRATION_WINDOW = 25
+
+// Null move pruning is tried from this depth, reducing the search after
+// the pass by this much plus a ply for every 4 of depth
+const NULL_MOVE_DEPTH = 3
+const NULL_MOVE_REDUCTION = 2
+
+// Late move reductions apply from this depth, to moves after this many
+const LMR_DEPTH = 3
+const LMR_MOVES = 3
+
+// Futility pruning applies up to this depth, with this margin per ply
+const FUTILITY_DEPTH = 2
+const FUTILITY_MARGIN = 200
+
+const REVERSE_FUTILITY_DEPTH = 4
+const REVERSE_FUTILITY_MARGIN = 120
+
+// Plies to reduce late moves by, indexed by depth and move number
+var lmrReductions [MAX_DEPTH][MAX_MOVES]int
+
+func init() {
+	for depth := 1; depth < MAX_DEPTH; depth++ {
+		for moves := 1; moves < MAX_MOVES; moves++ {
+			lmrReductions[depth][moves] = int(0.75 +
+				math.Log(float64(depth)) * math.Log(float64(moves)) / 2.25)
+		}
+	}
+}
+
+// Selective search techniques, each of which can be turned off to measure
+// what it is worth
+type SearchOptions struct {
+	// Principal variation search: moves after the first are searched with
+	// a null window, and only searched again if they beat it
+	PVS bool
+	NullMove bool
+	// Late move reductions
+	LMR bool
+	Futility bool
+	ReverseFutility bool
+	CheckExtensions bool
+	AspirationWindows bool
+
+	// Table kept between searches, e.g. over the moves of a game. If nil,
+	// each search uses a MIN_HASH_MB table of its own.
+	Table *TranspositionTable
+}
+
+// A named option switching a search technique on or off
+type searchSwitch struct {
+	name string
+	value *bool
+}
+
+// Returns options with every technique turned on
+func DefaultSearchOptions() SearchOptions {
+	return SearchOptions{
+		PVS               : true,
+		NullMove          : true,
+		LMR               : true,
+		Futility          : true,
+		ReverseFutility   : true,
+		CheckExtensions   : true,
+		AspirationWindows : true,
+	}
+}
+
+// Returns each option by the name the engine protocols know it by
+func (options *SearchOptions) switches() []searchSwitch {
+	return []searchSwitch{
+		{"PVS", &options.PVS},
+		{"NullMove", &options.NullMove},
+		{"LMR", &options.LMR},
+		{"Futility", &options.Futility},
+		{"ReverseFutility", &options.ReverseFutility},
+		{"CheckExtensions", &options.CheckExtensions},
+		{"AspirationWindows", &options.AspirationWindows},
+	}
+}
+
+// Limits on a search. Without any, it stops at DEFAULT_DEPTH.
+type SearchLimits struct {
+	Depth int
+	Nodes uint64
+	// Time to spend on the move, overriding the clock
+	MoveTime time.Duration
+	// Clock remaining and increment per move, indexed by Color
+	Time [2]time.Duration
+	Inc [2]time.Duration
+	// Moves until the next time control, or 0 if none
+	MovesToGo int
+	// Search until stopped
+	Infinite bool
+}
+
+// Outcome of a completed search iteration
+type SearchResult struct {
+	Move Move
+	// Centipawns from the side to move's view
+	Score int
+	// Moves until mate, negative if the side to move is being mated, or 0
+	// if no mate was found
+	Mate int
+	Depth int
+	Nodes uint64
+	Elapsed time.Duration
+	// Principal variation, starting with Move
+	PV []Move
+}
+
+type searchState struct {
+	stop int32
+	deadline int64
+	soft int64
+	nodes uint64
+	maxNodes uint64
+	table *TranspositionTable
+	options SearchOptions
+
+	// Triangular table of principal variations, one row per ply
+	pv [MAX_PLY][MAX_PLY]Move
+	pvLength [MAX_PLY]int
+	// Principal variation of the last completed iteration, searched first
+	prevPV []Move
+	rootPly int
+
+	// Quiet moves that caused cutoffs, by ply, by the move they answered
+	// and by color, from and to squares
+	killers [MAX_PLY][2]Move
+	counters [2][7][64]Move
+	history [2][64][64]int
+}
+
+// Returns nodes searched per second
+func (result SearchResult) NPS() uint64 {
+	var ms int64 = result.Elapsed.Milliseconds()
+	if ms <= 0 {
+		return 0
+	}
+	return result.Nodes * 1000 / uint64(ms)
+}
+
+// Searches the current position for the best move within limits, calling
+// report after each completed iteration if it is not nil. The search
+// ends early once ctx is done. The game itself is left untouched.
+func (game *Game) Search(ctx context.Context, limits SearchLimits,
+						 report func(SearchResult)) SearchResult {
+	return game.SearchWithOptions(ctx, limits, DefaultSearchOptions(), report)
+}
+
+// Searches like Search, using only the selective techniques turned on in
+// options
+func (game *Game) SearchWithOptions(ctx context.Context, limits SearchLimits,
+									options SearchOptions,
+									report func(SearchResult)) SearchResult {
+	var table *TranspositionTable = options.Table
+	if table == nil {
+		table = NewTranspositionTable(MIN_HASH_MB)
+	}
+	var state *searchState = &searchState{
+		maxNodes : limits.Nodes,
+		table    : table,
+		options  : options,
+	}
+	var budget time.Duration = limits.budget(game.turn)
+	if budget > 0 {
+		state.setDeadline(budget)
+	}
+
+	var done chan bool = make(chan bool)
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			state.halt()
+		case <-done:
+		}
+	}()
+
+	return think(game.copy(), limits.maxDepth(), state, report)
+}
+
+// Returns the time the engine should spend on the current move, or zero
+// if the search should only end on depth, nodes or an explicit stop
+func (limits *SearchLimits) budget(color Color) time.Duration {
+	if limits.Infinite {
+		return 0
+	} else if limits.MoveTime > 0 {
+		return limits.MoveTime
+	} else if limits.Time[color] <= 0 {
+		return 0
+	}
+
+	var movesToGo int = limits.MovesToGo
+	if movesToGo <= 0 {
+		movesToGo = DEFAULT_MOVES_TO_GO
+	}
+
+	var remaining time.Duration = limits.Time[color] - MOVE_OVERHEAD
+	if remaining < limits.Time[color] / 2 {
+		remaining = limits.Time[color] / 2
+	}
+
+	var budget time.Duration = (remaining / time.Duration(movesToGo)) +
+							   (limits.Inc[color] * 3 / 4)
+	if budget > remaining {
+		budget = remaining
+	}
+	return budget
+}
+
+// Returns max depth to search given the limits set by the caller
+func (limits *SearchLimits) maxDepth() int {
+	if limits.Depth > 0 {
+		if limits.Depth > MAX_DEPTH {
+			return MAX_DEPTH
+		}
+		return limits.Depth
+	} else if limits.Infinite || limits.Nodes > 0 || limits.MoveTime > 0 ||
+			  limits.Time[WHITE] > 0 || limits.Time[BLACK] > 0 {
+		return MAX_DEPTH
+	}
+	return DEFAULT_DEPTH
+}
+
+func (state *searchState) setDeadline(budget time.Duration) {
+	var now time.Time = time.Now()
+	atomic.StoreInt64(&state.soft, now.Add(budget / 2).UnixNano())
+	atomic.StoreInt64(&state.deadline, now.Add(budget).UnixNano())
+}
+
+func (state *searchState) halt() {
+	atomic.StoreInt32(&state.stop, 1)
+}
+
+func (state *searchState) stopped() bool {
+	return atomic.LoadInt32(&state.stop) != 0
+}
+
+func (state *searchState) shouldStop() bool {
+	if state.stopped() {
+		return true
+	} else if (state.maxNodes != 0) && (state.nodes >= state.maxNodes) {
+		state.halt()
+		return true
+	}
+
+	// Only poll the clock every 1024 nodes
+	if (state.nodes & 1023) == 0 {
+		var deadline int64 = atomic.LoadInt64(&state.deadline)
+		if (deadline != 0) && (time.Now().UnixNano() >= deadline) {
+			state.halt()
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if there is not enough time left to finish another iteration
+func (state *searchState) pastSoftLimit() bool {
+	var soft int64 = atomic.LoadInt64(&state.soft)
+	return (soft != 0) && (time.Now().UnixNano() >= soft)
+}
+
+// Iteratively deepens negamax until the depth limit is reached or the
+// search is stopped, reporting each completed iteration. Results from an
+// interrupted iteration are discarded.
+func think(game *Game, depth int, state *searchState,
+		   report func(SearchResult)) SearchResult {
+	var start time.Time = time.Now()
+	var result SearchResult
+	var moves []Move = game.getValidMoves()
+	if len(moves) == 0 {
+		return result
+	}
+
+	result.Move = moves[0]
+	result.PV = []Move{moves[0]}
+	state.rootPly = len(game.moves)
+	if state.table != nil {
+		state.table.newSearch()
+	}
+	for i := 1; i <= depth; i++ {
+		var score int = state.aspirate(game, i, result.Score)
+		if state.stopped() || (state.pvLength[0] == 0) {
+			break
+		}
+
+		var pv []Move = make([]Move, state.pvLength[0])
+		copy(pv, state.pv[0][:state.pvLength[0]])
+		state.prevPV = pv
+
+		result = SearchResult{
+			Move    : pv[0],
+			Score   : score,
+			Mate    : mateIn(score),
+			Depth   : i,
+			Nodes   : state.nodes,
+			Elapsed : time.Since(start),
+			PV      : pv,
+		}
+		if report != nil {
+			report(result)
+		}
+
+		// A deeper search cannot find a shorter mate
+		if (result.Mate != 0) && (MATE_SCORE - abs(score) <= i) {
+			break
+		} else if state.pastSoftLimit() {
+			break
+		}
+	}
+
+	result.Nodes = state.nodes
+	result.Elapsed = time.Since(start)
+	return result
+}
+
+// Searches the root in a narrow window around the last iteration's score,
+// widening it on the side the score falls outside of until it fits
+func (state *searchState) aspirate(game *Game, depth int, last int) int {
+	if !state.options.AspirationWindows || (depth < ASPIRATION_DEPTH) ||
+	   (mateIn(last) != 0) {
+		return state.negamax(game, depth, 0, -INFINITE_SCORE, INFINITE_SCORE)
+	}
+
+	var delta int = ASPIRATION_WINDOW
+	var alpha int = last - delta
+	var beta int = last + delta
+	for {
+		var score int = state.negamax(game, depth, 0, alpha, beta)
+		if state.stopped() {
+			return score
+		}
+
+		if score <= alpha {
+			alpha -= delta
+		} else if score >= beta {
+			beta += delta
+		} else {
+			return score
+		}
+
+		delta *= 2
+		if alpha < -INFINITE_SCORE {
+			alpha = -INFINITE_SCORE
+		}
+		if beta > INFINITE_SCORE {
+			beta = INFINITE_SCORE
+		}
+	}
+}
+
+// Scores the position from the side to move's view to the given depth,
+// recording the principal variation from ply onwards
+func (state *searchState) negamax(game *Game, depth int, ply int,
+								  alpha int, beta int) int {
+	var options *SearchOptions = &state.options
+	var inCheck bool = game.board.isKingInCheck(game.turn)
+	if inCheck && options.CheckExtensions {
+		depth++
+	}
+
+	if depth <= 0 {
+		return state.quiescence(game, ply, alpha, beta)
+	}
+
+	state.pvLength[ply] = 0
+	state.nodes++
+	if state.shouldStop() {
+		return 0
+	}
+
+	if (ply > 0) && game.isSearchDraw() {
+		return 0
+	} else if ply >= MAX_PLY - 1 {
+		return evaluate(game)
+	}
+
+	// Nodes searched with a null window only need to prove a bound, so
+	// they can be pruned; the principal variation cannot
+	var pvNode bool = (beta - alpha) > 1
+
+	// Reuse an earlier search of this position if it went deep enough,
+	// except on the principal variation, which would be cut short
+	var hashMove Move = state.pvMove(game, ply)
+	if entry, ok := state.table.probe(game.board.hash, ply); ok {
+		hashMove = entry.move
+		if !pvNode && (entry.depth >= depth) &&
+		   ((entry.bound == BOUND_EXACT) ||
+			((entry.bound == BOUND_LOWER) && (entry.score >= beta)) ||
+			((entry.bound == BOUND_UPPER) && (entry.score <= alpha))) {
+			return entry.score
+		}
+	}
+
+	var prunable bool = !pvNode && !inCheck && (abs(beta) < MATE_SCORE - MAX_PLY)
+	var eval int = 0
+	if !inCheck {
+		eval = evaluate(game)
+	}
+
+	// Far enough above beta that no reply will bring it back down
+	if options.ReverseFutility && prunable && (depth <= REVERSE_FUTILITY_DEPTH) &&
+	   (eval - REVERSE_FUTILITY_MARGIN * depth >= beta) {
+		return eval
+	}
+
+	// If passing still fails high, a real move would too. Passing can be
+	// the best option in zugzwang, which is rare with pieces left, and two
+	// passes in a row would prove nothing.
+	if options.NullMove && prunable && (depth >= NULL_MOVE_DEPTH) &&
+	   (eval >= beta) && (game.lastMove() != NO_MOVE) &&
+	   game.board.hasPieces(game.turn) {
+		var reduction int = NULL_MOVE_REDUCTION + depth / 4
+		game.makeNullMove()
+		var score int = -state.negamax(game, depth - 1 - reduction, ply + 1,
+									   -beta, -beta + 1)
+		game.undoNullMove()
+		if state.stopped() {
+			return 0
+		}
+
+		if score >= beta {
+			// Mates found after passing are not proven
+			if score >= MATE_SCORE - MAX_PLY {
+				return beta
+			}
+			return score
+		}
+	}
+
+	// Near the leaves, quiet moves cannot lift a position this far below
+	// alpha
+	var futile bool = options.Futility && prunable && (depth <= FUTILITY_DEPTH) &&
+					  (eval + FUTILITY_MARGIN * depth <= alpha)
+
+	var picker movePicker
+	picker.init(game, state, ply, hashMove)
+
+	var best int = -INFINITE_SCORE
+	var bestMove Move = NO_MOVE
+	var bound ttBound = BOUND_UPPER
+	var legal int = 0
+	var quiets []Move
+	for move := picker.next(); move != NO_MOVE; move = picker.next() {
+		legal++
+		game.makeMove(move)
+		var givesCheck bool = game.board.isKingInCheck(game.turn)
+		var quiet bool = !isTactical(move) && !givesCheck
+		if futile && quiet && (legal > 1) {
+			game.undoMove()
+			continue
+		}
+
+		var score int
+		if legal == 1 {
+			score = -state.negamax(game, depth - 1, ply + 1, -beta, -alpha)
+		} else {
+			// Late quiet moves are unlikely to be best, so are searched
+			// shallower first
+			var reduction int = 0
+			if options.LMR && quiet && !inCheck && (depth >= LMR_DEPTH) &&
+			   (legal > LMR_MOVES) {
+				reduction = lmrReduction(depth, legal)
+			}
+
+			// Later moves only need to be shown worse than the best so far
+			var window int = beta
+			if options.PVS {
+				window = alpha + 1
+			}
+
+			score = -state.negamax(game, depth - 1 - reduction, ply + 1,
+								   -window, -alpha)
+			if (score > alpha) && (reduction > 0) {
+				score = -state.negamax(game, depth - 1, ply + 1, -window, -alpha)
+			}
+			if (score > alpha) && (score < beta) && (window != beta) {
+				score = -state.negamax(game, depth - 1, ply + 1, -beta, -alpha)
+			}
+		}
+		game.undoMove()
+		if state.stopped() {
+			return 0
+		}
+
+		if score > best {
+			best = score
+			bestMove = move
+			if score > alpha {
+				alpha = score
+				bound = BOUND_EXACT
+				state.updatePV(ply, move)
+			}
+			if alpha >= beta {
+				bound = BOUND_LOWER
+				if !isTactical(move) {
+					state.updateQuietStats(game, move, ply, depth, quiets)
+				}
+				break
+			}
+		}
+		if !isTactical(move) {
+			quiets = append(quiets, move)
+		}
+	}
+
+	if legal == 0 {
+		if inCheck {
+			return -MATE_SCORE + ply
+		}
+		return 0
+	}
+
+	state.table.store(game.board.hash, bestMove, best, depth, bound, ply)
+	return best
+}
+
+// Returns how many plies to reduce a late move by, more the deeper the
+// search and the later the move
+func lmrReduction(depth int, moveNumber int) int {
+	if depth >= MAX_DEPTH {
+		depth = MAX_DEPTH - 1
+	}
+	if moveNumber >= MAX_MOVES {
+		moveNumber = MAX_MOVES - 1
+	}
+
+	var reduction int = lmrReductions[depth][moveNumber]
+	if reduction > depth - 2 {
+		reduction = depth - 2
+	}
+	return reduction
+}
+
+// Searches captures and promotions that do not lose material until the
+// position is quiet, so the evaluation is not taken mid-exchange. The side
+// to move may stand pat on the evaluation unless in check, when every
+// evasion is searched.
+func (state *searchState) quiescence(game *Game, ply int, alpha int,
+									 beta int) int {
+	state.pvLength[ply] = 0
+	state.nodes++
+	if state.shouldStop() {
+		return 0
+	}
+
+	if game.isSearchDraw() {
+		return 0
+	} else if ply >= MAX_PLY - 1 {
+		return evaluate(game)
+	}
+
+	var picker movePicker
+	picker.init(game, state, ply, NO_MOVE)
+
+	var inCheck bool = game.board.isKingInCheck(game.turn)
+	var best int = -INFINITE_SCORE
+	if !inCheck {
+		best = evaluate(game)
+		if best >= beta {
+			return best
+		} else if best > alpha {
+			alpha = best
+		}
+		picker.tacticalOnly = true
+	}
+
+	var searched int = 0
+	for move := picker.next(); move != NO_MOVE; move = picker.next() {
+		searched++
+		game.makeMove(move)
+		var score int = -state.quiescence(game, ply + 1, -beta, -alpha)
+		game.undoMove()
+		if state.stopped() {
+			return 0
+		}
+
+		if score > best {
+			best = score
+			if score > alpha {
+				alpha = score
+				state.updatePV(ply, move)
+			}
+			if alpha >= beta {
+				break
+			}
+		}
+	}
+
+	if inCheck && (searched == 0) {
+		return -MATE_SCORE + ply
+	}
+	return best
+}
+
+// Makes move the head of the principal variation at ply, followed by the
+// variation found below it
+func (state *searchState) updatePV(ply int, move Move) {
+	state.pv[ply][0] = move
+	copy(state.pv[ply][1:], state.pv[ply + 1][:state.pvLength[ply + 1]])
+	state.pvLength[ply] = state.pvLength[ply + 1] + 1
+}
+
+// Returns the previous iteration's principal variation move for this
+// node, if it lies on that variation
+func (state *searchState) pvMove(game *Game, ply int) Move {
+	if ply >= len(state.prevPV) {
+		return NO_MOVE
+	}
+	for i := 0; i < ply; i++ {
+		if game.moves[state.rootPly + i] != state.prevPV[i] {
+			return NO_MOVE
+		}
+	}
+	return state.prevPV[ply]
+}
+
+// Returns true if the position is drawn by the fifty-move rule, a repeat
+// of an earlier position or insufficient material. One repetition is
+// enough, as a side that could avoid it would have.
+func (game *Game) isSearchDraw() bool {
+	return (game.halfmove >= FIFTY_MOVE_PLIES) ||
+		   (game.countRepetitions() > 1) ||
+		   (!game.board.hasMatingMaterial(WHITE) &&
+			!game.board.hasMatingMaterial(BLACK))
+}
+
+// Returns moves until mate for a mate score, negative if the side to move
+// is being mated, or 0 for any other score
+func mateIn(score int) int {
+	if score > MATE_SCORE - MAX_PLY {
+		return (MATE_SCORE - score + 1) / 2
+	} else if score < -MATE_SCORE + MAX_PLY {
+		return -(MATE_SCORE + score + 1) / 2
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+package goengine
+
+// Returns the material in centipawns the side playing move wins or loses
+// once every capture on its target square has been played out, each side
+// recapturing with its least valuable piece and free to stop. Pins are
+// ignored.
+func (game *Game) SEE(move Move) int {
+	var board *Board = game.board
+	var from uint64 = move.fromBB()
+	var occupied uint64 = ^board.piece[EMPTY] ^ from
+
+	var gain int = pieceValue[move.Captured()]
+	var piece Piece = move.Piece()
+	if move.Flag() == PROMOTION {
+		gain += pieceValue[move.Promotion()] - pieceValue[PAWN]
+		piece = move.Promotion()
+	} else if move.Flag() == EP_CAPTURE {
+		gain = pieceValue[PAWN]
+		occupied &= ^(board.ep & (^move.toBB()))
+	}
+	return board.exchange(uint8(move.To()), piece, oppColor[move.Color()],
+						  occupied, gain)
+}
+
+// Returns the squares of color's pieces that the opponent could win
+// material by capturing, were it the opponent's move
+func (game *Game) HangingPieces(color Color) []Square {
+	var board *Board = game.board
+	var occupied uint64 = ^board.piece[EMPTY]
+	var hanging []Square
+
+	var pieces uint64 = board.color[color] & (^board.piece[KING])
+	for pieces != 0 {
+		var sqr uint8 = bitScanForward(pieces)
+		pieces &= pieces - 1
+
+		var attackers uint64 = board.attackersTo(sqr, occupied) &
+							   board.color[oppColor[color]]
+		attacker, from := board.leastValuable(attackers)
+		if from == 0 {
+			continue
+		}
+
+		var victim Piece = board.findPiece(1 << sqr)
+		var gain int = board.exchange(sqr, attacker, color, occupied ^ from,
+									  pieceValue[victim])
+		if gain > 0 {
+			hanging = append(hanging, Square(sqr))
+		}
+	}
+	return hanging
+}
+
+// Plays out captures on sqr, where piece has just captured for gain and
+// color is next to recapture, and returns the gain for the first capturer
+func (board *Board) exchange(sqr uint8, piece Piece, color Color,
+							 occupied uint64, gain int) int {
+	var gains [32]int
+	gains[0] = gain
+	var depth int = 0
+
+	for depth + 1 < len(gains) {
+		var attackers uint64 = board.attackersTo(sqr, occupied) & occupied
+		next, from := board.leastValuable(attackers & board.color[color])
+		if from == 0 {
+			break
+		}
+
+		// A king cannot recapture onto a defended square
+		var defenders uint64 = attackers & board.color[oppColor[color]]
+		if (next == KING) && (defenders != 0) {
+			break
+		}
+
+		depth++
+		gains[depth] = pieceValue[piece] - gains[depth - 1]
+		occupied ^= from
+		piece = next
+		color = oppColor[color]
+	}
+
+	// Either side may stand pat rather than continue a losing exchange
+	for ; depth > 0; depth-- {
+		if gains[depth] > -gains[depth - 1] {
+			gains[depth - 1] = -gains[depth]
+		}
+	}
+	return gains[0]
+}
+
+// Returns the least valuable piece among attackers and its square
+func (board *Board) leastValuable(attackers uint64) (Piece, uint64) {
+	for piece := PAWN; ; piece-- {
+		var bb uint64 = attackers & board.piece[piece]
+		if bb != 0 {
+			return piece, bb & -bb
+		}
+		if piece == KING {
+			return EMPTY, 0
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package goengine
+
+import (
+	"strings"
+)
+
+const LIGHT_SQUARES = 0xAA55AA55AA55AA55
+const DARK_SQUARES = ^uint64(LIGHT_SQUARES)
+
+// Plies without a capture or pawn move before a draw can be claimed,
+// and before the game is drawn automatically
+const FIFTY_MOVE_PLIES = 100
+const SEVENTY_FIVE_MOVE_PLIES = 150
+
+// Why a game ended
+type Termination uint8
+const (
+	// Game is in play, or ended for a reason that was not recorded
+	NO_TERMINATION Termination = iota
+	CHECKMATE
+	STALEMATE
+	INSUFFICIENT_MATERIAL
+	THREEFOLD_REPETITION
+	FIVEFOLD_REPETITION
+	FIFTY_MOVE_RULE
+	SEVENTY_FIVE_MOVE_RULE
+	RESIGNATION
+	DRAW_AGREED
+	TIME_FORFEIT
+	// Flag fell, but the opponent has no way to checkmate
+	TIMEOUT_VS_INSUFFICIENT_MATERIAL
+	// Decided by an arbiter, e.g. in an adjourned game
+	ADJUDICATION
+	// Lost by breaking the rules or abandoning the game
+	FORFEIT
+)
+
+var terminationToString = [...]string{
+	"",
+	"checkmate",
+	"stalemate",
+	"insufficient material",
+	"threefold repetition",
+	"fivefold repetition",
+	"fifty-move rule",
+	"seventy-five-move rule",
+	"resignation",
+	"draw agreed",
+	"time forfeit",
+	"timeout vs insufficient material",
+	"adjudication",
+	"forfeit",
+}
+
+var statusToString = [...]string{"*", "1-0", "0-1", "1/2-1/2"}
+
+func (termination Termination) String() string {
+	return terminationToString[termination]
+}
+
+// Returns the result in PGN notation, e.g. 1-0
+func (status GameStatus) String() string {
+	return statusToString[status]
+}
+
+// Returns the game's result and the reason it ended. Threefold repetition
+// and the fifty-move rule only end the game once claimed, see ClaimDraw.
+func (game *Game) getResult() (GameStatus, Termination) {
+	// Results decided off the board take precedence
+	if game.status != IN_PLAY {
+		return game.status, game.termination
+	}
+
+	if !game.hasValidMoves() {
+		if !game.board.isKingInCheck(game.turn) {
+			return DRAW, STALEMATE
+		} else if game.turn == WHITE {
+			return BLACK_WON, CHECKMATE
+		} else {
+			return WHITE_WON, CHECKMATE
+		}
+	}
+
+	if !game.board.hasMatingMaterial(WHITE) &&
+	   !game.board.hasMatingMaterial(BLACK) {
+		return DRAW, INSUFFICIENT_MATERIAL
+	}
+
+	if game.countRepetitions() >= 5 {
+		return DRAW, FIVEFOLD_REPETITION
+	} else if game.halfmove >= SEVENTY_FIVE_MOVE_PLIES {
+		return DRAW, SEVENTY_FIVE_MOVE_RULE
+	}
+
+	return IN_PLAY, NO_TERMINATION
+}
+
+// Returns the draw the side to move may claim, THREEFOLD_REPETITION or
+// FIFTY_MOVE_RULE, or NO_TERMINATION if there is none
+func (game *Game) ClaimableDraw() Termination {
+	if game.getGameStatus() != IN_PLAY {
+		return NO_TERMINATION
+	} else if game.countRepetitions() >= 3 {
+		return THREEFOLD_REPETITION
+	} else if game.halfmove >= FIFTY_MOVE_PLIES {
+		return FIFTY_MOVE_RULE
+	}
+	return NO_TERMINATION
+}
+
+// Returns true if the side to move may claim a draw
+func (game *Game) CanClaimDraw() bool {
+	return game.ClaimableDraw() != NO_TERMINATION
+}
+
+// Ends the game in a draw claimed by the side to move, by threefold
+// repetition or the fifty-move rule
+func (game *Game) ClaimDraw() error {
+	var termination Termination = game.ClaimableDraw()
+	if termination == NO_TERMINATION {
+		return ErrNoDrawClaim
+	}
+	game.status = DRAW
+	game.termination = termination
+	return nil
+}
+
+// Returns how many times the current position has occurred, counting
+// back to the last capture or pawn move
+func (game *Game) countRepetitions() int {
+	var count int = 1
+	var plies int = int(game.halfmove)
+	for i := 2; i <= plies && i <= len(game.undo); i += 2 {
+		if game.undo[len(game.undo) - i].hash == game.board.hash {
+			count++
+		}
+	}
+	return count
+}
+
+// Returns false if color cannot checkmate by any series of legal moves,
+// e.g. a lone king, a lone minor piece or bishops all on one color
+func (board *Board) hasMatingMaterial(color Color) bool {
+	var own uint64 = board.color[color]
+	var heavy uint64 = board.piece[QUEEN] | board.piece[ROOK] | board.piece[PAWN]
+	if (heavy & own) != 0 {
+		return true
+	}
+
+	var minors uint64 = (board.piece[KNIGHT] | board.piece[BISHOP]) & own
+	if minors == 0 {
+		return false
+	}
+
+	// Bishops on one color with nothing else but kings can never mate
+	var bishops uint64 = board.piece[BISHOP]
+	var others uint64 = ^board.piece[EMPTY] & (^board.piece[KING])
+	if ((others & (^bishops)) == 0) &&
+	   (((bishops & LIGHT_SQUARES) == 0) || ((bishops & DARK_SQUARES) == 0)) {
+		return false
+	}
+
+	// A lone minor piece needs an opposing piece to block its king in
+	var opp uint64 = board.color[oppColor[color]] & (^board.piece[KING])
+	if ((minors & (minors - 1)) == 0) && (opp == 0) {
+		return false
+	}
+	return true
+}
+
+// Ends the game with color resigning
+func (game *Game) Resign(color Color) {
+	game.status = WHITE_WON
+	if color == WHITE {
+		game.status = BLACK_WON
+	}
+	game.termination = RESIGNATION
+}
+
+// Ends the game in a draw by mutual agreement
+func (game *Game) AgreeDraw() {
+	game.status = DRAW
+	game.termination = DRAW_AGREED
+}
+
+// Ends the game with color's flag falling. The game is drawn if the
+// opponent has no way to checkmate.
+func (game *Game) ForfeitOnTime(color Color) {
+	if !game.board.hasMatingMaterial(oppColor[color]) {
+		game.status = DRAW
+		game.termination = TIMEOUT_VS_INSUFFICIENT_MATERIAL
+		return
+	}
+
+	game.status = WHITE_WON
+	if color == WHITE {
+		game.status = BLACK_WON
+	}
+	game.termination = TIME_FORFEIT
+}
+
+// Records the result of a game read from PGN and why it ended: by the
+// final position if it decides that result, otherwise by the PGN
+// Termination tag. Decisive games that ended normally off the board are
+// taken as resigned and drawn ones as agreed, unless a draw could be
+// claimed.
+func (game *Game) setPGNResult(result string, tag string) {
+	game.status = IN_PLAY
+	game.termination = NO_TERMINATION
+	onBoard, termination := game.getResult()
+	var claimable Termination = game.ClaimableDraw()
+
+	game.setGameStatus(result)
+	if (game.status == IN_PLAY) || (game.status == onBoard) {
+		game.status = IN_PLAY
+		return
+	}
+
+	switch strings.ToLower(tag) {
+	case "time forfeit":
+		termination = TIME_FORFEIT
+		if game.status == DRAW {
+			termination = TIMEOUT_VS_INSUFFICIENT_MATERIAL
+		}
+	case "adjudication":
+		termination = ADJUDICATION
+	case "abandoned", "rules infraction":
+		termination = FORFEIT
+	default:
+		termination = RESIGNATION
+		if (game.status == DRAW) && (claimable != NO_TERMINATION) {
+			termination = claimable
+		} else if game.status == DRAW {
+			termination = DRAW_AGREED
+		}
+	}
+	game.termination = termination
+}
+
+// Returns why the game ended, or NO_TERMINATION if it is still in play
+func (game *Game) Termination() Termination {
+	_, termination := game.getResult()
+	return termination
+}
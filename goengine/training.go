@@ -0,0 +1,466 @@
+package goengine
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Bytes in a packed board: occupancy, a nibble per piece, then side to
+// move with castling rights, the En Passant square and the half move clock
+const PACKED_BOARD_SIZE = 27
+
+// Bytes in a binary training sample: the packed board, then the move, ply,
+// result, eval and both ratings, little endian
+const TRAINING_SAMPLE_SIZE = PACKED_BOARD_SIZE + 11
+
+// Binary evals of forced mates count down from MATE_EVAL, and other evals
+// are capped below it
+const MATE_EVAL = 30000
+const MAX_EVAL = MATE_EVAL - 1000
+const NO_EVAL = math.MinInt16
+
+// Output formats for training samples
+type TrainingFormat uint8
+const (
+	JSONL_FORMAT TrainingFormat = iota
+	BINARY_FORMAT
+)
+
+// A position from a game along with the move played from it
+type TrainingSample struct {
+	FEN string `json:"fen"`
+	Board PackedBoard `json:"-"`
+	SideToMove string `json:"stm"`
+	// Move played, in coordinate notation
+	Move string `json:"move"`
+	Result string `json:"result"`
+	// Plies played before the position
+	Ply int `json:"ply"`
+	// Engine eval of the position from a [%eval] comment, in centipawns
+	// from white's view, or moves to mate
+	Eval *int `json:"eval,omitempty"`
+	Mate *int `json:"mate,omitempty"`
+	WhiteElo int `json:"white_elo,omitempty"`
+	BlackElo int `json:"black_elo,omitempty"`
+}
+
+// Picks the games worth learning from
+type TrainingFilter struct {
+	// Rating both players need, if set
+	MinElo int
+	// Speeds allowed, e.g. "blitz", as given by TimeControlSpeed. Any
+	// speed is allowed if empty.
+	Speeds []string
+	// Opening plies left out of each game
+	SkipPlies int
+}
+
+var evalPattern = regexp.MustCompile(`\[%eval\s+(#?)([-+]?[0-9.]+)`)
+
+// Returns the speed of a game with the given TimeControl tag, e.g. 300+3,
+// going by its estimated length of base + 40 increments: ultrabullet,
+// bullet, blitz, rapid, classical or correspondence. Returns an empty
+// string if the time control is unknown.
+func TimeControlSpeed(timeControl string) string {
+	if timeControl == "-" {
+		return "correspondence"
+	}
+
+	var parts []string = strings.Split(timeControl, "+")
+	base, err := strconv.Atoi(parts[0])
+	if (err != nil) || (len(parts) > 2) {
+		return ""
+	}
+	var increment int = 0
+	if len(parts) == 2 {
+		increment, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return ""
+		}
+	}
+
+	var estimate int = base + (40 * increment)
+	switch {
+	case estimate < 30:
+		return "ultrabullet"
+	case estimate < 180:
+		return "bullet"
+	case estimate < 480:
+		return "blitz"
+	case estimate < 1500:
+		return "rapid"
+	}
+	return "classical"
+}
+
+// Returns true if the game has a result and meets the filter's rating and
+// speed limits
+func (filter TrainingFilter) Accepts(pgn *PGNGame) bool {
+	if (pgn.Result == "*") || (pgn.Result == "") {
+		return false
+	}
+
+	if filter.MinElo > 0 {
+		white, _ := strconv.Atoi(pgn.Tag("WhiteElo"))
+		black, _ := strconv.Atoi(pgn.Tag("BlackElo"))
+		if (white < filter.MinElo) || (black < filter.MinElo) {
+			return false
+		}
+	}
+
+	if len(filter.Speeds) == 0 {
+		return true
+	}
+	var speed string = TimeControlSpeed(pgn.Tag("TimeControl"))
+	for _, allowed := range filter.Speeds {
+		if speed == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a sample for every position of the game's main line with a move
+// played from it, leaving out the first skipPlies
+func TrainingSamples(pgn *PGNGame, skipPlies int) ([]TrainingSample, error) {
+	game, err := pgn.StartingGame()
+	if err != nil {
+		return nil, err
+	}
+
+	whiteElo, _ := strconv.Atoi(pgn.Tag("WhiteElo"))
+	blackElo, _ := strconv.Atoi(pgn.Tag("BlackElo"))
+
+	var samples []TrainingSample
+	var ply int = 0
+	for node := pgn.Root; node.Next() != nil; node = node.Next() {
+		var move Move = node.Next().Move
+		if ply >= skipPlies {
+			var sample TrainingSample = TrainingSample{
+				FEN        : game.FEN(),
+				Board      : game.Pack(),
+				SideToMove : colorToString[game.turn],
+				Move       : move.uciString(),
+				Result     : pgn.Result,
+				Ply        : ply,
+				WhiteElo   : whiteElo,
+				BlackElo   : blackElo,
+			}
+			// The eval after a move is the eval of the position it reaches
+			sample.Eval, sample.Mate = parseEval(node.Comments)
+			samples = append(samples, sample)
+		}
+
+		game.makeMove(move)
+		ply++
+	}
+	return samples, nil
+}
+
+// Reads the eval from comments such as { [%eval 0.17] } or
+// { [%eval #-3] }, given in pawns or moves to mate
+func parseEval(comments []string) (*int, *int) {
+	for _, comment := range comments {
+		var match []string = evalPattern.FindStringSubmatch(comment)
+		if match == nil {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			continue
+		}
+		var score int = int(math.Round(value * 100))
+		if match[1] == "#" {
+			score = int(value)
+			return nil, &score
+		}
+		return &score, nil
+	}
+	return nil, nil
+}
+
+// Writes training samples from every game the filter accepts, with games
+// replayed on the pipeline's workers. Returns the pipeline's report and
+// the number of samples written.
+func ExportTrainingData(ctx context.Context, parser *PGNParser, writer io.Writer,
+						format TrainingFormat, filter TrainingFilter,
+						pipeline *Pipeline) (PipelineReport, int, error) {
+	type encodedGame struct {
+		data []byte
+		count int
+	}
+
+	var run Pipeline = *pipeline
+	run.Work = func(pgn *PGNGame) (interface{}, error) {
+		if !filter.Accepts(pgn) {
+			return encodedGame{}, nil
+		}
+		samples, err := TrainingSamples(pgn, filter.SkipPlies)
+		if err != nil {
+			return nil, err
+		}
+
+		var data []byte
+		for _, sample := range samples {
+			data, err = sample.appendTo(data, format)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return encodedGame{data: data, count: len(samples)}, nil
+	}
+
+	var buffered *bufio.Writer = bufio.NewWriter(writer)
+	var count int = 0
+	report, err := run.Run(ctx, parser, func(result PipelineResult) error {
+		if result.Err != nil {
+			return nil
+		}
+		var encoded encodedGame = result.Value.(encodedGame)
+		count += encoded.count
+		_, err := buffered.Write(encoded.data)
+		return err
+	})
+
+	flushErr := buffered.Flush()
+	if err == nil {
+		err = flushErr
+	}
+	return report, count, err
+}
+
+func (sample TrainingSample) appendTo(data []byte, format TrainingFormat) ([]byte, error) {
+	if format == JSONL_FORMAT {
+		line, err := json.Marshal(sample)
+		if err != nil {
+			return data, err
+		}
+		return append(append(data, line...), '\n'), nil
+	}
+
+	move, err := stringToPackedMove(sample.Move)
+	if err != nil {
+		return data, err
+	}
+
+	var eval int = NO_EVAL
+	if sample.Mate != nil {
+		eval = MATE_EVAL - abs(*sample.Mate)
+		if *sample.Mate < 0 {
+			eval = -eval
+		}
+	} else if sample.Eval != nil {
+		eval = *sample.Eval
+		if eval > MAX_EVAL {
+			eval = MAX_EVAL
+		} else if eval < -MAX_EVAL {
+			eval = -MAX_EVAL
+		}
+	}
+
+	var record [TRAINING_SAMPLE_SIZE]byte
+	copy(record[:], sample.Board[:])
+	var rest []byte = record[PACKED_BOARD_SIZE:]
+	binary.LittleEndian.PutUint16(rest[0:], move)
+	binary.LittleEndian.PutUint16(rest[2:], uint16(sample.Ply))
+	rest[4] = byte(resultToInt(sample.Result))
+	binary.LittleEndian.PutUint16(rest[5:], uint16(int16(eval)))
+	binary.LittleEndian.PutUint16(rest[7:], uint16(sample.WhiteElo))
+	binary.LittleEndian.PutUint16(rest[9:], uint16(sample.BlackElo))
+	return append(data, record[:]...), nil
+}
+
+// Reads a sample written in the binary format, or io.EOF when there are
+// none left
+func ReadTrainingSample(reader io.Reader) (TrainingSample, error) {
+	var record [TRAINING_SAMPLE_SIZE]byte
+	_, err := io.ReadFull(reader, record[:])
+	if err != nil {
+		return TrainingSample{}, err
+	}
+
+	var sample TrainingSample
+	copy(sample.Board[:], record[:PACKED_BOARD_SIZE])
+	game, err := sample.Board.Game()
+	if err != nil {
+		return sample, err
+	}
+	sample.FEN = game.FEN()
+	sample.SideToMove = colorToString[game.turn]
+
+	var rest []byte = record[PACKED_BOARD_SIZE:]
+	sample.Move = packedMoveToString(binary.LittleEndian.Uint16(rest[0:]))
+	sample.Ply = int(binary.LittleEndian.Uint16(rest[2:]))
+	sample.Result = intToResult(int8(rest[4]))
+	var eval int = int(int16(binary.LittleEndian.Uint16(rest[5:])))
+	if (eval > MAX_EVAL) || ((eval < -MAX_EVAL) && (eval != NO_EVAL)) {
+		var mate int = MATE_EVAL - abs(eval)
+		if eval < 0 {
+			mate = -mate
+		}
+		sample.Mate = &mate
+	} else if eval != NO_EVAL {
+		sample.Eval = &eval
+	}
+	sample.WhiteElo = int(binary.LittleEndian.Uint16(rest[7:]))
+	sample.BlackElo = int(binary.LittleEndian.Uint16(rest[9:]))
+	return sample, nil
+}
+
+// Moves in the binary format: from, to and promotion piece, 6 bits each
+// for the squares and 3 for the piece
+func stringToPackedMove(uci string) (uint16, error) {
+	if (len(uci) != 4) && (len(uci) != 5) {
+		return 0, ErrInvalidNotation
+	}
+	from, err := stringToSqr(uci[0:2])
+	if err != nil {
+		return 0, err
+	}
+	to, err := stringToSqr(uci[2:4])
+	if err != nil {
+		return 0, err
+	}
+
+	var promo Piece = EMPTY
+	if len(uci) == 5 {
+		var index int = strings.Index("qrbn", uci[4:])
+		if index < 0 {
+			return 0, ErrInvalidPromotion
+		}
+		promo = QUEEN + Piece(index)
+	}
+	return uint16(from) | (uint16(to) << 6) | (uint16(promo) << 12), nil
+}
+
+func packedMoveToString(move uint16) string {
+	var uci string = sqrToString(uint8(move & 0x3F)) +
+					 sqrToString(uint8((move >> 6) & 0x3F))
+	var promo Piece = Piece((move >> 12) & 0x07)
+	if promo != EMPTY {
+		uci += pieceToString[BLACK][promo]
+	}
+	return uci
+}
+
+func resultToInt(result string) int8 {
+	switch result {
+	case "1-0":
+		return 1
+	case "0-1":
+		return -1
+	}
+	return 0
+}
+
+func intToResult(result int8) string {
+	switch result {
+	case 1:
+		return "1-0"
+	case -1:
+		return "0-1"
+	}
+	return "1/2-1/2"
+}
+
+// A position in 27 bytes, enough to set up the game again without its
+// history or full move number
+type PackedBoard [PACKED_BOARD_SIZE]byte
+
+// Returns the current position as a packed board
+func (game *Game) Pack() PackedBoard {
+	var packed PackedBoard
+	var board *Board = game.board
+	var occupied uint64 = ^board.piece[EMPTY]
+	binary.LittleEndian.PutUint64(packed[0:], occupied)
+
+	// Pieces in order of their squares, color in the high bit of a nibble
+	var i int = 0
+	for bb := occupied; bb != 0; bb &= bb - 1 {
+		var sqr uint64 = bb & -bb
+		var code byte = byte(board.findPiece(sqr)) | (byte(board.findColor(sqr)) << 3)
+		packed[8 + i / 2] |= code << (4 * uint(i % 2))
+		i++
+	}
+
+	var flags byte = byte(game.turn)
+	for color := WHITE; color <= BLACK; color++ {
+		if (board.castle[color] & K_CASTLE_MASK) != 0 {
+			flags |= 0x02 << (2 * color)
+		}
+		if (board.castle[color] & Q_CASTLE_MASK) != 0 {
+			flags |= 0x04 << (2 * color)
+		}
+	}
+	packed[24] = flags
+
+	packed[25] = 0xFF
+	if (board.ep & board.piece[EMPTY]) != 0 {
+		packed[25] = bitScanForward(board.ep & board.piece[EMPTY])
+	}
+
+	packed[26] = 0xFF
+	if game.halfmove < 0xFF {
+		packed[26] = byte(game.halfmove)
+	}
+	return packed
+}
+
+// Sets up the packed position as a new game
+func (packed PackedBoard) Game() (*Game, error) {
+	var occupied uint64 = binary.LittleEndian.Uint64(packed[0:])
+	if popCount(occupied) > 32 {
+		return nil, ErrInvalidPackedBoard
+	}
+
+	var board *Board = new(Board)
+	var i int = 0
+	for bb := occupied; bb != 0; bb &= bb - 1 {
+		var sqr uint64 = bb & -bb
+		var code byte = (packed[8 + i / 2] >> (4 * uint(i % 2))) & 0x0F
+		if Piece(code & 0x07) >= EMPTY {
+			return nil, ErrInvalidPackedBoard
+		}
+		board.piece[code & 0x07] |= sqr
+		board.color[code >> 3] |= sqr
+		i++
+	}
+	board.piece[EMPTY] = board.findEmptySpaces()
+
+	var turn Color = Color(packed[24] & 0x01)
+	for color := WHITE; color <= BLACK; color++ {
+		if (packed[24] & (0x02 << (2 * color))) != 0 {
+			board.castle[color] |= K_CASTLE_MASK
+		}
+		if (packed[24] & (0x04 << (2 * color))) != 0 {
+			board.castle[color] |= Q_CASTLE_MASK
+		}
+	}
+
+	if packed[25] < 64 {
+		var target uint64 = 1 << packed[25]
+		if turn == WHITE {
+			board.ep = target | moveSouth(target)
+		} else {
+			board.ep = target | moveNorth(target)
+		}
+	}
+
+	// Going through FEN checks the position makes sense
+	var game *Game = &Game{board: board, turn: turn, fullmove: 1,
+						   halfmove: uint16(packed[26])}
+	game, err := FromFEN(game.getFENString())
+	if err != nil {
+		return nil, fmt.Errorf("%w %s", ErrInvalidPackedBoard, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,236 @@
+package goengine
+
+import (
+	"sync/atomic"
+)
+
+// Transposition table size in megabytes, unless set by the GUI
+const DEFAULT_HASH_MB = 16
+const MIN_HASH_MB = 1
+const MAX_HASH_MB = 4096
+
+// Entries sharing one slot of the table, filling a 64-byte cache line
+const TT_BUCKET_SIZE = 4
+const TT_ENTRY_SIZE = 16
+
+// Searches an entry may be left over from before it is aged out, kept in
+// 6 bits
+const TT_AGE_MASK = 0x3F
+
+// How a stored score relates to the position's true score
+type ttBound uint8
+const (
+	// Marks an empty entry
+	BOUND_NONE ttBound = iota
+	BOUND_EXACT
+	// Score is at least the stored one, after a beta cutoff
+	BOUND_LOWER
+	// Score is at most the stored one, as no move raised alpha
+	BOUND_UPPER
+)
+
+// Results of earlier searches keyed by position hash, so positions reached
+// again by a different move order need not be searched twice. Entries are
+// read and written atomically, so one table may be shared by concurrent
+// searches.
+type TranspositionTable struct {
+	entries []ttEntry
+	mask uint64
+	age uint32
+}
+
+// The key is the position hash xor'd with the data, so a read that races
+// a write is seen as a miss rather than returning a torn entry
+type ttEntry struct {
+	key uint64
+	data uint64
+}
+
+type ttData struct {
+	move Move
+	score int
+	depth int
+	bound ttBound
+	age uint32
+}
+
+// Returns a table using up to mb megabytes
+func NewTranspositionTable(mb int) *TranspositionTable {
+	var table *TranspositionTable = &TranspositionTable{}
+	table.Resize(mb)
+	return table
+}
+
+// Reallocates the table to use up to mb megabytes, emptying it. It must
+// not be in use by a search.
+func (table *TranspositionTable) Resize(mb int) {
+	if mb < MIN_HASH_MB {
+		mb = MIN_HASH_MB
+	} else if mb > MAX_HASH_MB {
+		mb = MAX_HASH_MB
+	}
+
+	// Round down to a power of two buckets so the hash can be masked
+	var buckets uint64 = 1
+	for buckets * 2 * TT_BUCKET_SIZE * TT_ENTRY_SIZE <= uint64(mb) << 20 {
+		buckets *= 2
+	}
+	table.entries = make([]ttEntry, buckets * TT_BUCKET_SIZE)
+	table.mask = buckets - 1
+	table.age = 0
+}
+
+// Empties the table, e.g. for a new game. It must not be in use by a
+// search.
+func (table *TranspositionTable) Clear() {
+	for i := range table.entries {
+		table.entries[i] = ttEntry{}
+	}
+	table.age = 0
+}
+
+// Returns the size of the table in megabytes
+func (table *TranspositionTable) Size() int {
+	return len(table.entries) * TT_ENTRY_SIZE >> 20
+}
+
+// Returns how full the table is with entries from the current search, in
+// permille, from a sample of its first entries
+func (table *TranspositionTable) Hashfull() int {
+	var sample int = 1000
+	if sample > len(table.entries) {
+		sample = len(table.entries)
+	}
+
+	var age uint32 = atomic.LoadUint32(&table.age)
+	var used int = 0
+	for i := 0; i < sample; i++ {
+		var data ttData = unpackTTData(atomic.LoadUint64(&table.entries[i].data))
+		if (data.bound != BOUND_NONE) && (data.age == age) {
+			used++
+		}
+	}
+	return used * 1000 / sample
+}
+
+// Marks the start of a new search, so entries from earlier ones are
+// replaced first
+func (table *TranspositionTable) newSearch() {
+	var age uint32 = atomic.LoadUint32(&table.age)
+	atomic.StoreUint32(&table.age, (age + 1) & TT_AGE_MASK)
+}
+
+func (table *TranspositionTable) bucket(hash uint64) []ttEntry {
+	var start uint64 = (hash & table.mask) * TT_BUCKET_SIZE
+	return table.entries[start:start + TT_BUCKET_SIZE]
+}
+
+// Returns the entry stored for the position, with mate scores made
+// relative to the current ply
+func (table *TranspositionTable) probe(hash uint64, ply int) (ttData, bool) {
+	if table == nil {
+		return ttData{}, false
+	}
+
+	var bucket []ttEntry = table.bucket(hash)
+	for i := range bucket {
+		var data uint64 = atomic.LoadUint64(&bucket[i].data)
+		var key uint64 = atomic.LoadUint64(&bucket[i].key)
+		if (key ^ data == hash) && (data != 0) {
+			var entry ttData = unpackTTData(data)
+			entry.score = scoreFromTT(entry.score, ply)
+			return entry, true
+		}
+	}
+	return ttData{}, false
+}
+
+// Stores a search result for the position. An entry for the same position
+// is kept if it came from a deeper search in this one, otherwise the
+// bucket's oldest and shallowest entry is replaced.
+func (table *TranspositionTable) store(hash uint64, move Move, score int,
+									   depth int, bound ttBound, ply int) {
+	if table == nil {
+		return
+	}
+
+	var age uint32 = atomic.LoadUint32(&table.age)
+	var bucket []ttEntry = table.bucket(hash)
+	var victim *ttEntry = &bucket[0]
+	var worst int = MAX_INT
+	for i := range bucket {
+		var data uint64 = atomic.LoadUint64(&bucket[i].data)
+		var key uint64 = atomic.LoadUint64(&bucket[i].key)
+		var old ttData = unpackTTData(data)
+
+		if (data != 0) && (key ^ data == hash) {
+			if (bound != BOUND_EXACT) && (old.age == age) && (old.depth > depth) {
+				return
+			}
+			if move == NO_MOVE {
+				move = old.move
+			}
+			victim = &bucket[i]
+			break
+		} else if data == 0 {
+			victim = &bucket[i]
+			break
+		}
+
+		var value int = old.depth - 8 * int((age - old.age) & TT_AGE_MASK)
+		if value < worst {
+			worst = value
+			victim = &bucket[i]
+		}
+	}
+
+	var data uint64 = packTTData(ttData{
+		move  : move,
+		score : scoreToTT(score, ply),
+		depth : depth,
+		bound : bound,
+		age   : age,
+	})
+	atomic.StoreUint64(&victim.data, data)
+	atomic.StoreUint64(&victim.key, hash ^ data)
+}
+
+// Packs an entry as move (32 bits), score (16), depth (8), bound (2) and
+// age (6)
+func packTTData(entry ttData) uint64 {
+	return uint64(entry.move) |
+		   uint64(uint16(int16(entry.score))) << 32 |
+		   uint64(uint8(entry.depth)) << 48 |
+		   uint64(entry.bound) << 56 |
+		   uint64(entry.age & TT_AGE_MASK) << 58
+}
+
+func unpackTTData(data uint64) ttData {
+	return ttData{
+		move  : Move(uint32(data)),
+		score : int(int16(uint16(data >> 32))),
+		depth : int(uint8(data >> 48)),
+		bound : ttBound((data >> 56) & 0x3),
+		age   : uint32(data >> 58) & TT_AGE_MASK,
+	}
+}
+
+// Mate scores are stored as distance from the position rather than from
+// the root, as the position may be reached again at another ply
+func scoreToTT(score int, ply int) int {
+	if score > MATE_SCORE - MAX_PLY {
+		return score + ply
+	} else if score < -MATE_SCORE + MAX_PLY {
+		return score - ply
+	}
+	return score
+}
+
+func scoreFromTT(score int, ply int) int {
+	if score > MATE_SCORE - MAX_PLY {
+		return score - ply
+	} else if score < -MATE_SCORE + MAX_PLY {
+		return score + ply
+	}
+	return score
+}
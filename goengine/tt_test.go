@@ -0,0 +1,37 @@
+package goengine
+
+import "testing"
+
+func TestTTStore(t *testing.T) {
+	var table *TranspositionTable = NewTranspositionTable(1)
+	var move Move = newMove(QUIET, KNIGHT, WHITE, 1, 18, EMPTY, EMPTY)
+	var tests = []struct {
+		score int
+		ply int
+		probePly int
+		expected int
+	}{
+		{35, 3, 7, 35},
+		{-120, 0, 2, -120},
+		// Mating in 3 from the stored position, found 2 and then 6 plies in
+		{MATE_SCORE - 5, 2, 6, MATE_SCORE - 9},
+		{-MATE_SCORE + 4, 1, 3, -MATE_SCORE + 6},
+		{MATE_SCORE - 10, 8, 0, MATE_SCORE - 2},
+	}
+
+	for i, test := range tests {
+		var hash uint64 = 0x9E3779B97F4A7C15 * uint64(i + 1)
+		table.store(hash, move, test.score, 5, BOUND_LOWER, test.ply)
+		entry, ok := table.probe(hash, test.probePly)
+		if !ok || (entry.score != test.expected) || (entry.move != move) ||
+		   (entry.depth != 5) || (entry.bound != BOUND_LOWER) {
+			t.Errorf("%d stored at ply %d: expected %d at ply %d, got: %+v (%v)",
+					 test.score, test.ply, test.expected, test.probePly, entry, ok)
+		}
+
+		_, ok = table.probe(hash ^ 1, test.probePly)
+		if ok {
+			t.Errorf("%d: expected a miss for another position", test.score)
+		}
+	}
+}
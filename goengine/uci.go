@@ -0,0 +1,308 @@
+package goengine
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const ENGINE_NAME = "GoEngine"
+const ENGINE_AUTHOR = "Harrison McCarty"
+
+type uciSession struct {
+	engine *GoEngine
+	out io.Writer
+	outLock sync.Mutex
+
+	// State of the search in progress, if any
+	state *searchState
+	budget time.Duration
+	release chan bool
+	releaseOnce *sync.Once
+	done chan bool
+}
+
+// Runs the Universal Chess Interface protocol, reading commands from
+// reader and writing responses to writer until "quit" or end of input
+func (engine *GoEngine) RunUCI(reader io.Reader, writer io.Writer) error {
+	if engine.game == nil {
+		engine.game = &Game{}
+		engine.game.setup()
+	}
+
+	var session *uciSession = &uciSession{engine: engine, out: writer}
+	defer session.stopSearch()
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var args []string = strings.Fields(scanner.Text())
+		if len(args) == 0 {
+			continue
+		}
+
+		switch args[0] {
+		case "uci":
+			session.send("id name %s", ENGINE_NAME)
+			session.send("id author %s", ENGINE_AUTHOR)
+			session.send("option name Hash type spin default %d min %d max %d",
+						 DEFAULT_HASH_MB, MIN_HASH_MB, MAX_HASH_MB)
+			for _, option := range engine.searchOptions().switches() {
+				session.send("option name %s type check default %t", option.name,
+							 *option.value)
+			}
+			session.send("uciok")
+		case "isready":
+			session.send("readyok")
+		case "ucinewgame":
+			session.stopSearch()
+			engine.game = &Game{}
+			engine.game.setup()
+			engine.transpositionTable().Clear()
+		case "position":
+			session.stopSearch()
+			err := session.position(args[1:])
+			if err != nil {
+				session.send("info string %s", err)
+			}
+		case "go":
+			session.stopSearch()
+			err := session.goSearch(args[1:])
+			if err != nil {
+				session.send("info string %s", err)
+			}
+		case "stop":
+			session.stopSearch()
+		case "ponderhit":
+			session.ponderHit()
+		case "setoption":
+			session.stopSearch()
+			session.setOption(args[1:])
+		case "quit":
+			return nil
+		case "debug", "register":
+			// Nothing to configure
+		default:
+			session.send("info string Unknown command: %s", args[0])
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (session *uciSession) send(format string, args ...interface{}) {
+	session.outLock.Lock()
+	defer session.outLock.Unlock()
+	fmt.Fprintf(session.out, format + "\n", args...)
+}
+
+// Handles "position [startpos | fen <fen>] [moves <move1> ... <movei>]"
+func (session *uciSession) position(args []string) error {
+	var game *Game = &Game{}
+	game.setup()
+
+	var i int = 0
+	if len(args) > 0 && args[0] == "startpos" {
+		i = 1
+	} else if len(args) > 0 && args[0] == "fen" {
+		i = 1
+		for i < len(args) && args[i] != "moves" {
+			i++
+		}
+		err := game.setFENString(strings.Join(args[1:i], " "))
+		if err != nil {
+			return err
+		}
+	} else {
+		return errors.New("Invalid position command.")
+	}
+
+	if i < len(args) && args[i] == "moves" {
+		for _, cmd := range args[i + 1:] {
+			err := game.pushMove(cmd)
+			if err != nil {
+				return fmt.Errorf("%s: %s", cmd, err)
+			}
+		}
+	}
+
+	session.engine.game = game
+	return nil
+}
+
+// Handles "go" and its search limits, starting a search in the background
+// unless a limit is missing its value or has one out of range
+func (session *uciSession) goSearch(args []string) error {
+	var limits SearchLimits
+	var ponder bool = false
+	for i := 0; i < len(args); i++ {
+		var value int64 = 0
+		var err error = nil
+		if i + 1 < len(args) {
+			value, err = strconv.ParseInt(args[i + 1], 10, 64)
+		}
+		var ms time.Duration = time.Duration(value) * time.Millisecond
+
+		// Every limit but infinite and ponder takes a number, which only
+		// the clocks may leave at zero or below
+		var clock bool = false
+		switch args[i] {
+		case "wtime", "btime", "winc", "binc":
+			clock = true
+		}
+
+		switch args[i] {
+		case "wtime":
+			limits.Time[WHITE] = ms
+		case "btime":
+			limits.Time[BLACK] = ms
+		case "winc":
+			limits.Inc[WHITE] = ms
+		case "binc":
+			limits.Inc[BLACK] = ms
+		case "movestogo":
+			limits.MovesToGo = int(value)
+		case "depth":
+			limits.Depth = int(value)
+		case "nodes":
+			limits.Nodes = uint64(value)
+		case "movetime":
+			limits.MoveTime = ms
+		case "infinite":
+			limits.Infinite = true
+			continue
+		case "ponder":
+			ponder = true
+			continue
+		default:
+			continue
+		}
+		if (i + 1 >= len(args)) || (err != nil) || (!clock && (value <= 0)) {
+			return fmt.Errorf("Invalid %s value in go command.", args[i])
+		}
+		i++
+	}
+
+	var game *Game = session.engine.game.copy()
+	var state *searchState = &searchState{
+		maxNodes : limits.Nodes,
+		table    : session.engine.transpositionTable(),
+		options  : *session.engine.searchOptions(),
+	}
+	var budget time.Duration = limits.budget(game.turn)
+	if budget > 0 && !ponder {
+		state.setDeadline(budget)
+	}
+
+	// Infinite and ponder searches hold their result until told to move
+	var hold bool = limits.Infinite || ponder
+	var release chan bool = make(chan bool)
+	var done chan bool = make(chan bool)
+
+	session.state = state
+	session.budget = budget
+	session.release = release
+	session.releaseOnce = new(sync.Once)
+	session.done = done
+
+	go func() {
+		defer close(done)
+		var result SearchResult = think(game, limits.maxDepth(), state,
+										session.sendInfo)
+		if hold {
+			<-release
+		}
+
+		if result.Move == NO_MOVE {
+			session.send("bestmove 0000")
+		} else {
+			session.send("bestmove %s", result.Move.uciString())
+		}
+	}()
+	return nil
+}
+
+func (session *uciSession) sendInfo(result SearchResult) {
+	var score string = fmt.Sprintf("cp %d", result.Score)
+	if result.Mate != 0 {
+		score = fmt.Sprintf("mate %d", result.Mate)
+	}
+
+	var pv []string = make([]string, len(result.PV))
+	for i, move := range result.PV {
+		pv[i] = move.uciString()
+	}
+	session.send("info depth %d score %s nodes %d nps %d hashfull %d time %d pv %s",
+				 result.Depth, score, result.Nodes, result.NPS(),
+				 session.engine.transpositionTable().Hashfull(),
+				 result.Elapsed.Milliseconds(), strings.Join(pv, " "))
+}
+
+// Stops the search in progress and waits for its bestmove to be sent
+func (session *uciSession) stopSearch() {
+	if session.state == nil {
+		return
+	}
+
+	session.state.halt()
+	session.releaseOnce.Do(func() { close(session.release) })
+	<-session.done
+	session.state = nil
+}
+
+// The opponent played the expected move, so the ponder search continues
+// as a normal search on the clock
+func (session *uciSession) ponderHit() {
+	if session.state == nil {
+		return
+	}
+
+	if session.budget > 0 {
+		session.state.setDeadline(session.budget)
+	}
+	session.releaseOnce.Do(func() { close(session.release) })
+}
+
+// Handles "setoption name <id> [value <x>]"
+func (session *uciSession) setOption(args []string) {
+	var name []string
+	var value []string
+	for i := 0; i < len(args); i++ {
+		if args[i] == "name" {
+			continue
+		} else if args[i] == "value" {
+			value = args[i + 1:]
+			break
+		}
+		name = append(name, args[i])
+	}
+
+	if strings.EqualFold(strings.Join(name, " "), "Hash") {
+		mb, err := strconv.Atoi(strings.Join(value, ""))
+		if err != nil {
+			session.send("info string Invalid Hash value: %s",
+						 strings.Join(value, " "))
+			return
+		}
+		session.engine.transpositionTable().Resize(mb)
+		return
+	}
+
+	for _, option := range session.engine.searchOptions().switches() {
+		if strings.EqualFold(strings.Join(name, " "), option.name) {
+			enabled, err := strconv.ParseBool(strings.Join(value, ""))
+			if err != nil {
+				session.send("info string Invalid %s value: %s", option.name,
+							 strings.Join(value, " "))
+				return
+			}
+			*option.value = enabled
+			return
+		}
+	}
+	session.send("info string Unknown option: %s", strings.Join(name, " "))
+}
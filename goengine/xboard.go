@@ -0,0 +1,381 @@
+package goengine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Engine plays neither side while in force mode
+const NO_COLOR Color = 2
+
+const XBOARD_MATE_SCORE = 100000
+
+type xboardSession struct {
+	engine *GoEngine
+	out io.Writer
+	outLock sync.Mutex
+
+	// Guards engine.game between the command loop and a finished search
+	gameLock sync.Mutex
+
+	engineColor Color
+	post bool
+
+	// Time controls set by level, st and sd
+	movesPerSession int
+	base time.Duration
+	inc time.Duration
+	moveTime time.Duration
+	depth int
+	clock time.Duration
+
+	// State of the search in progress, if any
+	state *searchState
+	discard int32
+	done chan bool
+}
+
+// Runs the Chess Engine Communication Protocol (xboard/WinBoard), reading
+// commands from reader and writing responses to writer until "quit" or
+// end of input
+func (engine *GoEngine) RunXboard(reader io.Reader, writer io.Writer) error {
+	if engine.game == nil {
+		engine.game = &Game{}
+		engine.game.setup()
+	}
+
+	var session *xboardSession = &xboardSession{
+		engine      : engine,
+		out         : writer,
+		engineColor : BLACK,
+		post        : true,
+	}
+	defer session.stopSearch(true)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var args []string = strings.Fields(scanner.Text())
+		if len(args) == 0 {
+			continue
+		}
+
+		switch args[0] {
+		case "xboard", "accepted", "rejected", "random", "computer",
+			 "name", "rating", "hard", "easy", "ics":
+			// Nothing to configure
+		case "protover":
+			session.send("feature done=0")
+			session.send("feature myname=\"%s\" ping=1 setboard=1 " +
+						 "usermove=1 time=1 colors=0 analyze=0 memory=1 " +
+						 "sigint=0 sigterm=0 reuse=1", ENGINE_NAME)
+			for _, option := range engine.searchOptions().switches() {
+				var value int = 0
+				if *option.value {
+					value = 1
+				}
+				session.send("feature option=\"%s -check %d\"", option.name, value)
+			}
+			session.send("feature done=1")
+		case "option":
+			session.stopSearch(true)
+			session.option(strings.Join(args[1:], " "))
+		case "new":
+			session.stopSearch(true)
+			session.withGame(func(game *Game) {
+				game.setFENString(START_FEN)
+			})
+			session.engineColor = BLACK
+			session.depth = 0
+			session.engine.transpositionTable().Clear()
+		case "force":
+			session.stopSearch(true)
+			session.engineColor = NO_COLOR
+		case "go":
+			session.stopSearch(true)
+			session.engineColor = session.engine.game.turn
+			session.startSearch()
+		case "playother":
+			session.stopSearch(true)
+			session.engineColor = oppColor[session.engine.game.turn]
+		case "white", "black":
+			// Protocol version 1: gives that side the move, and the engine
+			// the other side
+			session.stopSearch(true)
+			var color Color = WHITE
+			if args[0] == "black" {
+				color = BLACK
+			}
+			var err error
+			session.withGame(func(game *Game) {
+				err = game.setSideToMove(color)
+			})
+			if err != nil {
+				session.send("tellusererror Illegal position: %s", err)
+			}
+			session.engineColor = oppColor[color]
+		case "usermove":
+			if len(args) > 1 {
+				session.userMove(args[1])
+			}
+		case "setboard":
+			session.stopSearch(true)
+			var fen string = strings.Join(args[1:], " ")
+			var err error
+			session.withGame(func(game *Game) {
+				err = game.setFENString(fen)
+			})
+			if err != nil {
+				session.send("tellusererror Illegal position: %s", err)
+			}
+		case "undo":
+			session.stopSearch(true)
+			session.undo(1)
+		case "remove":
+			session.stopSearch(true)
+			session.undo(2)
+		case "level":
+			session.level(args[1:])
+		case "st":
+			if len(args) > 1 {
+				seconds, _ := strconv.ParseFloat(args[1], 64)
+				session.moveTime = time.Duration(seconds * float64(time.Second))
+			}
+		case "sd":
+			if len(args) > 1 {
+				session.depth, _ = strconv.Atoi(args[1])
+			}
+		case "time":
+			if len(args) > 1 {
+				centis, _ := strconv.ParseInt(args[1], 10, 64)
+				session.clock = time.Duration(centis) * 10 * time.Millisecond
+			}
+		case "memory":
+			if len(args) > 1 {
+				session.stopSearch(true)
+				mb, _ := strconv.Atoi(args[1])
+				session.engine.transpositionTable().Resize(mb)
+			}
+		case "otim":
+			// Opponent's clock does not affect time management
+		case "post":
+			session.post = true
+		case "nopost":
+			session.post = false
+		case "?":
+			// Move now with the best move found so far
+			session.stopSearch(false)
+		case "ping":
+			if len(args) > 1 {
+				session.send("pong %s", args[1])
+			}
+		case "result":
+			session.stopSearch(true)
+			session.engineColor = NO_COLOR
+		case "quit":
+			return nil
+		default:
+			// Protocol version 1 sends moves without the usermove prefix
+			if isCoordinateMove(args[0]) {
+				session.userMove(args[0])
+			} else {
+				session.send("Error (unknown command): %s", args[0])
+			}
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (session *xboardSession) send(format string, args ...interface{}) {
+	session.outLock.Lock()
+	defer session.outLock.Unlock()
+	fmt.Fprintf(session.out, format + "\n", args...)
+}
+
+func (session *xboardSession) withGame(fn func(*Game)) {
+	session.gameLock.Lock()
+	defer session.gameLock.Unlock()
+	fn(session.engine.game)
+}
+
+func (session *xboardSession) userMove(cmd string) {
+	session.stopSearch(true)
+
+	var err error
+	var ended bool
+	session.withGame(func(game *Game) {
+		err = game.pushMove(cmd)
+		ended = (err == nil) && session.reportResult(game)
+	})
+
+	if err != nil {
+		session.send("Illegal move: %s", cmd)
+	} else if !ended && session.engine.game.turn == session.engineColor {
+		session.startSearch()
+	}
+}
+
+func isCoordinateMove(cmd string) bool {
+	if len(cmd) < 4 {
+		return false
+	}
+	_, fromErr := stringToSqr(cmd[0:2])
+	_, toErr := stringToSqr(cmd[2:4])
+	return (fromErr == nil) && (toErr == nil)
+}
+
+// Takes back plies moves, along with any passes made by the white and
+// black commands since
+func (session *xboardSession) undo(plies int) {
+	session.withGame(func(game *Game) {
+		for i := 0; i < plies && len(game.moves) > 0; i++ {
+			for (len(game.moves) > 0) && (game.lastMove() == NO_MOVE) {
+				game.undoNullMove()
+			}
+			if len(game.moves) > 0 {
+				game.undoMove()
+			}
+		}
+	})
+}
+
+// Handles "level MPS BASE INC", where BASE is minutes or minutes:seconds
+func (session *xboardSession) level(args []string) {
+	if len(args) < 3 {
+		return
+	}
+
+	session.movesPerSession, _ = strconv.Atoi(args[0])
+
+	var base []string = strings.Split(args[1], ":")
+	minutes, _ := strconv.Atoi(base[0])
+	session.base = time.Duration(minutes) * time.Minute
+	if len(base) > 1 {
+		seconds, _ := strconv.Atoi(base[1])
+		session.base += time.Duration(seconds) * time.Second
+	}
+
+	inc, _ := strconv.ParseFloat(args[2], 64)
+	session.inc = time.Duration(inc * float64(time.Second))
+	session.clock = session.base
+	session.moveTime = 0
+}
+
+// Sends the game result if the last move ended the game
+func (session *xboardSession) reportResult(game *Game) bool {
+	status, termination := game.getResult()
+	if status == IN_PLAY {
+		return false
+	}
+
+	var reason string = termination.String()
+	if termination == CHECKMATE && status == WHITE_WON {
+		reason = "White mates"
+	} else if termination == CHECKMATE {
+		reason = "Black mates"
+	}
+	session.send("%s {%s}", status,
+				 strings.ToUpper(reason[:1]) + reason[1:])
+	return true
+}
+
+func (session *xboardSession) startSearch() {
+	var game *Game = session.engine.game.copy()
+
+	var limits SearchLimits
+	limits.Depth = session.depth
+	limits.MoveTime = session.moveTime
+	if limits.MoveTime == 0 && session.clock > 0 {
+		limits.Time[game.turn] = session.clock
+		limits.Inc[game.turn] = session.inc
+		if session.movesPerSession > 0 {
+			var played int = (int(game.fullmove) - 1) % session.movesPerSession
+			limits.MovesToGo = session.movesPerSession - played
+		}
+	}
+
+	var state *searchState = &searchState{
+		table   : session.engine.transpositionTable(),
+		options : *session.engine.searchOptions(),
+	}
+	var budget time.Duration = limits.budget(game.turn)
+	if budget > 0 {
+		state.setDeadline(budget)
+	}
+
+	var done chan bool = make(chan bool)
+	session.state = state
+	session.done = done
+	atomic.StoreInt32(&session.discard, 0)
+
+	go func() {
+		defer close(done)
+		var result SearchResult = think(game, limits.maxDepth(), state,
+										session.sendThinking)
+		var move Move = result.Move
+		if move == NO_MOVE || atomic.LoadInt32(&session.discard) != 0 {
+			return
+		}
+
+		session.withGame(func(game *Game) {
+			game.makeMove(move)
+			session.send("move %s", move.uciString())
+			session.reportResult(game)
+		})
+	}()
+}
+
+// Handles "option NAME=VALUE" for the options sent with the features
+func (session *xboardSession) option(setting string) {
+	var parts []string = strings.SplitN(setting, "=", 2)
+	for _, option := range session.engine.searchOptions().switches() {
+		if (len(parts) == 2) && (parts[0] == option.name) {
+			*option.value = (parts[1] != "0")
+			return
+		}
+	}
+	session.send("Error (unknown option): %s", setting)
+}
+
+func (session *xboardSession) sendThinking(result SearchResult) {
+	if !session.post {
+		return
+	}
+
+	// Mates are reported as 100000 plus the moves to mate
+	var score int = result.Score
+	if result.Mate > 0 {
+		score = XBOARD_MATE_SCORE + result.Mate
+	} else if result.Mate < 0 {
+		score = -XBOARD_MATE_SCORE + result.Mate
+	}
+
+	var pv []string = make([]string, len(result.PV))
+	for i, move := range result.PV {
+		pv[i] = move.uciString()
+	}
+	session.send("%d %d %d %d %s", result.Depth, score,
+				 result.Elapsed.Milliseconds() / 10, result.Nodes,
+				 strings.Join(pv, " "))
+}
+
+// Stops the search in progress, discarding its move if requested, and
+// waits for it to finish
+func (session *xboardSession) stopSearch(discard bool) {
+	if session.state == nil {
+		return
+	}
+
+	if discard {
+		atomic.StoreInt32(&session.discard, 1)
+	}
+	session.state.halt()
+	<-session.done
+	session.state = nil
+}
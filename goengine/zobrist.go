@@ -0,0 +1,120 @@
+package goengine
+
+import "errors"
+
+// Seed for the key generator, fixed so hashes are stable across runs
+const ZOBRIST_SEED uint64 = 0x9E3779B97F4A7C15
+
+var ErrHashMismatch = errors.New("Incremental hash does not match position.")
+
+// When enabled, every move made or taken back verifies the incremental
+// hash against a full recomputation and panics on a mismatch
+var DebugHash bool = false
+
+var pieceKeys [2][6][64]uint64
+var castleKeys [16]uint64
+var epKeys [8]uint64
+var sideKey uint64
+
+func init() {
+	var seed uint64 = ZOBRIST_SEED
+	for color := 0; color < 2; color++ {
+		for piece := 0; piece < 6; piece++ {
+			for sqr := 0; sqr < 64; sqr++ {
+				pieceKeys[color][piece][sqr] = nextRandom(&seed)
+			}
+		}
+	}
+	for i := range castleKeys {
+		castleKeys[i] = nextRandom(&seed)
+	}
+	for i := range epKeys {
+		epKeys[i] = nextRandom(&seed)
+	}
+	sideKey = nextRandom(&seed)
+}
+
+// Xorshift64* pseudo-random number generator
+func nextRandom(seed *uint64) uint64 {
+	*seed ^= *seed >> 12
+	*seed ^= *seed << 25
+	*seed ^= *seed >> 27
+	return *seed * 0x2545F4914F6CDD1D
+}
+
+func pieceKey(color Color, piece Piece, bb uint64) uint64 {
+	return pieceKeys[color][piece][bitScanForward(bb)]
+}
+
+// Returns the hash of everything but piece placement: castling rights,
+// en passant file and side to move
+func (board *Board) stateHash(turn Color) uint64 {
+	var idx uint8 = 0
+	if (board.castle[WHITE] & K_CASTLE_MASK) != 0 {
+		idx |= 1
+	}
+	if (board.castle[WHITE] & Q_CASTLE_MASK) != 0 {
+		idx |= 2
+	}
+	if (board.castle[BLACK] & K_CASTLE_MASK) != 0 {
+		idx |= 4
+	}
+	if (board.castle[BLACK] & Q_CASTLE_MASK) != 0 {
+		idx |= 8
+	}
+
+	var hash uint64 = castleKeys[idx]
+	if turn == BLACK {
+		hash ^= sideKey
+	}
+
+	// Only hash the en passant file if a pawn can actually capture,
+	// so transpositions with a dead ep square still match
+	var pushed uint64 = board.ep & board.piece[PAWN]
+	if pushed != 0 {
+		var attackers uint64 = (moveEast(pushed) | moveWest(pushed)) &
+							   board.piece[PAWN] & board.color[turn]
+		if attackers != 0 {
+			hash ^= epKeys[bitScanForward(pushed) % 8]
+		}
+	}
+	return hash
+}
+
+// Computes the hash of the position from scratch
+func (board *Board) computeHash(turn Color) uint64 {
+	var hash uint64 = board.stateHash(turn)
+	for color := WHITE; color <= BLACK; color++ {
+		for piece := KING; piece < EMPTY; piece++ {
+			var bb uint64 = board.getBB(piece, color)
+			for bb != 0 {
+				var sqr uint8 = bitScanForward(bb)
+				hash ^= pieceKeys[color][piece][sqr]
+				bb ^= (1 << sqr)
+			}
+		}
+	}
+	return hash
+}
+
+// Returns the Zobrist hash of the current position
+func (game *Game) Hash() uint64 {
+	return game.board.hash
+}
+
+// Compares the incrementally updated hash against a full recomputation
+func (game *Game) VerifyHash() error {
+	if game.board.hash != game.board.computeHash(game.turn) {
+		return ErrHashMismatch
+	}
+	return nil
+}
+
+func (game *Game) debugHash() {
+	if DebugHash {
+		err := game.VerifyHash()
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -3,17 +3,79 @@ package main
 import (
 	"os"
 	"bufio"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"strconv"
 	"sync"
 	"strings"
+	"time"
 	"github.com/hmccarty/gochess/goengine"
 )
 
 func main() {
 	engine := goengine.GoEngine{}
 
-	// Create games from PGN format
-	//engine.scanPGN("goengine/evaluator/dataset/2017-01.bare.[7705].pgn", 1)
+	// Speak UCI or xboard over stdin/stdout when launched by a GUI, or
+	// run perft, read, index, explore or export PGN from the command line
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "uci":
+			engine.RunUCI(os.Stdin, os.Stdout)
+			return
+		case "xboard":
+			engine.RunXboard(os.Stdin, os.Stdout)
+			return
+		case "perft":
+			err := engine.RunPerft(os.Args[2:], os.Stdout)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			return
+		case "pgn":
+			err := printPGNPositions(os.Args[2:])
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			return
+		case "index":
+			err := runIndexCommand(os.Args[2:])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "explore":
+			err := runExploreCommand(os.Args[2:])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "play":
+			// Optionally with an opening explorer database to consult
+			if len(os.Args) > 2 {
+				explorer, err := goengine.OpenExplorer(os.Args[2])
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				defer explorer.Close()
+				engine.SetExplorer(explorer)
+			}
+		case "export":
+			err := exportTrainingData(os.Args[2:])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
 
 	// Creates new game within console
 	startClientGame(engine)
@@ -46,9 +108,230 @@ func handleGame(inputChan chan string, outputChan chan string,
 				printBoard(update[1])
 			case "client":
 				printBoard(update[1])
-				fmt.Print("Action (move, resign or draw): ")
+				fmt.Print("Action (move, explore, resign or draw): ")
 				response, _ := reader.ReadString('\n')
 				outputChan <- strings.TrimSpace(response)
 		}
 	}
 }
+
+// Prints the final position of every game in a PGN file, which may be
+// compressed, starting from an optional byte offset. Malformed games are
+// reported along with their offset and skipped.
+func printPGNPositions(args []string) error {
+	if len(args) == 0 {
+		return errors.New("Usage: pgn <file> [offset]")
+	}
+
+	var offset int64 = 0
+	if len(args) > 1 {
+		var err error
+		offset, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return errors.New("Usage: pgn <file> [offset]")
+		}
+	}
+
+	parser, err := goengine.OpenPGN(args[0], offset)
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	for result := range parser.Games(context.Background()) {
+		var pgnErr *goengine.PGNError
+		if errors.As(result.Err, &pgnErr) {
+			fmt.Fprintf(os.Stderr, "Skipped game, error at offset %d: %s\n",
+						pgnErr.Offset, result.Err)
+			continue
+		} else if result.Err != nil {
+			return result.Err
+		}
+
+		game, err := result.Game.Game()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipped game at offset %d: %s\n",
+						result.Game.Offset, err)
+			continue
+		}
+		fmt.Printf("%d %s\n", result.Game.Offset, game.FEN())
+	}
+	return nil
+}
+
+// Writes a training sample for every position of every game in a PGN
+// file, reporting progress on stderr
+func exportTrainingData(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "jsonl", "jsonl or binary")
+	output := flags.String("o", "", "output file, stdout if not set")
+	minElo := flags.Int("min-elo", 0, "rating both players need")
+	speeds := flags.String("speed", "", "comma separated speeds to keep, e.g. blitz,rapid")
+	skip := flags.Int("skip", 0, "opening plies to leave out")
+	workers := flags.Int("workers", 0, "games replayed at once, one per CPU if not set")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	} else if flags.NArg() != 1 {
+		return errors.New("Usage: export [options] <file>")
+	}
+
+	var filter goengine.TrainingFilter = goengine.TrainingFilter{
+		MinElo    : *minElo,
+		SkipPlies : *skip,
+	}
+	if *speeds != "" {
+		filter.Speeds = strings.Split(*speeds, ",")
+	}
+
+	var trainingFormat goengine.TrainingFormat
+	switch *format {
+	case "jsonl":
+		trainingFormat = goengine.JSONL_FORMAT
+	case "binary":
+		trainingFormat = goengine.BINARY_FORMAT
+	default:
+		return fmt.Errorf("Unknown format: %s", *format)
+	}
+
+	parser, err := goengine.OpenPGN(flags.Arg(0), 0)
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	var writer io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		writer = file
+	}
+
+	var pipeline *goengine.Pipeline = &goengine.Pipeline{
+		Workers  : *workers,
+		Progress : func(report goengine.PipelineReport) {
+			fmt.Fprintf(os.Stderr, "\r%d games, %d errors, %.0f games/s",
+						report.Games, len(report.Errors),
+						report.GamesPerSecond())
+		},
+	}
+	report, samples, err := goengine.ExportTrainingData(context.Background(),
+		parser, writer, trainingFormat, filter, pipeline)
+	fmt.Fprintf(os.Stderr, "\nWrote %d samples in %s\n", samples,
+				report.Elapsed.Round(time.Millisecond))
+	return err
+}
+
+// Builds or queries a position index:
+//	index build <pgn file> <index file>
+//	index find <index file> <fen>
+//	index material <index file> <signature, e.g. KRPvKR>
+func runIndexCommand(args []string) error {
+	var usage error = errors.New("Usage: index build <pgn> <index> | " +
+		"index find <index> <fen> | index material <index> <signature>")
+	if len(args) < 3 {
+		return usage
+	}
+
+	if args[0] == "build" {
+		var pipeline *goengine.Pipeline = &goengine.Pipeline{
+			Progress : func(report goengine.PipelineReport) {
+				fmt.Fprintf(os.Stderr, "\r%d games, %d errors, %.0f games/s",
+							report.Games, len(report.Errors),
+							report.GamesPerSecond())
+			},
+		}
+		_, err := goengine.BuildIndex(context.Background(), args[1], args[2],
+									  pipeline)
+		fmt.Fprintln(os.Stderr)
+		return err
+	}
+
+	index, err := goengine.OpenIndex(args[1])
+	if err != nil {
+		return err
+	}
+	defer index.Close()
+
+	var matches []goengine.IndexMatch
+	switch args[0] {
+	case "find":
+		matches, err = index.Find(strings.Join(args[2:], " "))
+	case "material":
+		matches, err = index.FindMaterial(strings.Join(args[2:], " "))
+	default:
+		return usage
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		var dots string = "."
+		if match.SideToMove == goengine.BLACK {
+			dots = "..."
+		}
+		fmt.Printf("Game %d at offset %d, move %d%s %s\n", match.Game,
+				   match.Offset, match.MoveNumber, dots, match.Result)
+	}
+
+	var stats goengine.IndexStats = goengine.MatchStats(matches)
+	fmt.Printf("%d games: +%d =%d -%d, %d unfinished\n", stats.Games,
+			   stats.WhiteWins, stats.Draws, stats.BlackWins, stats.Unfinished)
+	return nil
+}
+
+// Builds or views an opening explorer database:
+//	explore build [-plies n] <database> <pgn files...>
+//	explore <database> [fen]
+func runExploreCommand(args []string) error {
+	if (len(args) > 0) && (args[0] == "build") {
+		flags := flag.NewFlagSet("explore build", flag.ContinueOnError)
+		plies := flags.Int("plies", goengine.DEFAULT_EXPLORER_PLIES,
+						   "plies of each game to add")
+		err := flags.Parse(args[1:])
+		if err != nil {
+			return err
+		} else if flags.NArg() < 2 {
+			return errors.New("Usage: explore build [-plies n] <database> <pgn files...>")
+		}
+
+		var pipeline *goengine.Pipeline = &goengine.Pipeline{
+			Progress : func(report goengine.PipelineReport) {
+				fmt.Fprintf(os.Stderr, "\r%d games, %d errors, %.0f games/s",
+							report.Games, len(report.Errors),
+							report.GamesPerSecond())
+			},
+		}
+		_, err = goengine.BuildExplorer(context.Background(), flags.Args()[1:],
+										flags.Arg(0), *plies, pipeline)
+		fmt.Fprintln(os.Stderr)
+		return err
+	} else if len(args) == 0 {
+		return errors.New("Usage: explore <database> [fen]")
+	}
+
+	explorer, err := goengine.OpenExplorer(args[0])
+	if err != nil {
+		return err
+	}
+	defer explorer.Close()
+
+	var game *goengine.Game = goengine.NewGame()
+	if len(args) > 1 {
+		game, err = goengine.FromFEN(strings.Join(args[1:], " "))
+		if err != nil {
+			return err
+		}
+	}
+
+	moves, err := explorer.Explore(game)
+	if err != nil {
+		return err
+	}
+	fmt.Print(goengine.FormatExplorerMoves(moves))
+	return nil
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func playOpening(t *testing.T, moves string) *goengine.Game {
+	var game *goengine.Game = goengine.NewGame()
+	for _, san := range strings.Fields(moves) {
+		err := game.PushSAN(san)
+		if err != nil {
+			t.Fatalf("Failed to play %s: %v", san, err)
+		}
+	}
+	return game
+}
+
+func TestOpening(t *testing.T) {
+	var tests = []struct {
+		moves string
+		eco string
+		name string
+	}{
+		{"e4 e5 Nf3 Nc6 Bb5 a6", "C70", "Ruy Lopez: Morphy Defense"},
+		// Out of book moves keep the deepest opening reached
+		{"e4 e5 Nf3 Nc6 Bb5 a6 h3 h6", "C70", "Ruy Lopez: Morphy Defense"},
+		// Reached by transposition from 1. c4 e6 2. Nf3 d5
+		{"Nf3 d5 c4 e6", "A13", "English Opening: Agincourt Defense"},
+	}
+
+	for _, test := range tests {
+		opening, ok := playOpening(t, test.moves).Opening()
+		if !ok || (opening.ECO != test.eco) || (opening.Name != test.name) {
+			t.Errorf("Expected %s %s after %s, got: %s %s", test.eco, test.name,
+					 test.moves, opening.ECO, opening.Name)
+		}
+	}
+
+	if _, ok := goengine.NewGame().Opening(); ok {
+		t.Errorf("Expected no opening for the starting position")
+	}
+}
+
+func TestPGNOpening(t *testing.T) {
+	games, err := goengine.ParsePGN(strings.NewReader(ANNOTATED_PGN))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Variations are not classified
+	opening, ok := games[0].Opening()
+	if !ok || (opening.ECO != "C70") {
+		t.Errorf("Expected C70, got: %s %s", opening.ECO, opening.Name)
+	}
+
+	// Tags already in the game are kept
+	games, err = goengine.ParsePGN(strings.NewReader(
+		"[ECO \"C60\"]\n\n1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 *\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported string = games[0].String()
+	if !strings.Contains(exported, "[ECO \"C60\"]") ||
+	   strings.Contains(exported, "[Opening ") {
+		t.Errorf("Unexpected tags in export:\n%s", exported)
+	}
+}
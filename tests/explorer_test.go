@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestOpeningExplorer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "explorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var pgnFile string = filepath.Join(dir, "games.pgn")
+	var dbFile string = filepath.Join(dir, "explorer.db")
+	err = ioutil.WriteFile(pgnFile, []byte(TRAINING_PGN), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Building twice adds the games again
+	for i := 0; i < 2; i++ {
+		_, err = goengine.BuildExplorer(context.Background(), []string{pgnFile},
+										dbFile, 2, &goengine.Pipeline{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	explorer, err := goengine.OpenExplorer(dbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer explorer.Close()
+
+	// The unfinished game is left out
+	var game *goengine.Game = goengine.NewGame()
+	moves, err := explorer.Explore(game)
+	if err != nil || len(moves) != 2 {
+		t.Fatalf("Expected 2 moves from the start, got: %+v (%v)", moves, err)
+	}
+	for _, move := range moves {
+		switch move.SAN {
+		case "e4":
+			if move.Games != 2 || move.WhiteWins != 2 || move.WhitePercent() != 100 ||
+			   move.AverageRating != 2100 || move.AverageOpponent != 1950 {
+				t.Errorf("Unexpected stats for e4: %+v", move)
+			}
+		case "f3":
+			if move.Games != 2 || move.BlackWins != 2 || move.AverageRating != 2500 {
+				t.Errorf("Unexpected stats for f3: %+v", move)
+			}
+		default:
+			t.Errorf("Unexpected move: %s", move.SAN)
+		}
+	}
+
+	game.PushSAN("e4")
+	moves, err = explorer.Explore(game)
+	if err != nil || len(moves) != 1 || moves[0].SAN != "d5" ||
+	   moves[0].AverageRating != 1950 {
+		t.Errorf("Expected d5 after e4, got: %+v (%v)", moves, err)
+	}
+	if !strings.Contains(goengine.FormatExplorerMoves(moves), "d5") {
+		t.Errorf("Expected d5 in table, got:\n%s", goengine.FormatExplorerMoves(moves))
+	}
+
+	// Only the first two plies were added
+	game.PushSAN("d5")
+	moves, err = explorer.Explore(game)
+	if err != nil || len(moves) != 0 {
+		t.Errorf("Expected no moves past the ply limit, got: %+v (%v)", moves, err)
+	}
+
+	_, err = goengine.OpenExplorer(pgnFile)
+	if err != goengine.ErrInvalidExplorer {
+		t.Errorf("Expected invalid database, got: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestFENRoundTrip(t *testing.T) {
+	fens := []string {
+		goengine.START_FEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+		"r3k2r/8/8/8/8/8/8/R3K2R b Kq - 99 300",
+		"8/8/1k6/2b5/2pP4/8/5K2/8 b - d3 0 1",
+	}
+
+	for _, fen := range fens {
+		game, err := goengine.FromFEN(fen)
+		if err != nil {
+			t.Errorf("%s: %s", fen, err)
+		} else if game.FEN() != fen {
+			t.Errorf("Expected %s, got: %s", fen, game.FEN())
+		}
+	}
+}
+
+func TestShredderFEN(t *testing.T) {
+	game, err := goengine.FromFEN("r3k2r/8/8/8/8/8/8/R3K2R w HAh - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.FEN() != "r3k2r/8/8/8/8/8/8/R3K2R w KQk - 0 1" {
+		t.Errorf("Unexpected FEN, got: %s", game.FEN())
+	}
+	if game.ShredderFEN() != "r3k2r/8/8/8/8/8/8/R3K2R w HAh - 0 1" {
+		t.Errorf("Unexpected Shredder-FEN, got: %s", game.ShredderFEN())
+	}
+}
+
+func TestChess960Castling(t *testing.T) {
+	// X-FEN letters name the outermost rook, which must be in the corner
+	_, err := goengine.FromFEN("1r2k1r1/8/8/8/8/8/8/1R2K1R1 w KQkq - 0 1")
+	var fenErr *goengine.FENError
+	if !errors.As(err, &fenErr) || fenErr.Field != "castling" {
+		t.Errorf("Expected castling error for X-FEN, got: %v", err)
+	}
+
+	// Shredder-FEN files other than a and h are rejected outright
+	_, err = goengine.FromFEN("1r2k1r1/8/8/8/8/8/8/1R2K1R1 w GBgb - 0 1")
+	if !errors.As(err, &fenErr) || fenErr.Field != "castling" ||
+	   !strings.Contains(fenErr.Reason, "Chess960") {
+		t.Errorf("Expected Chess960 castling error, got: %v", err)
+	}
+}
+
+func TestInvalidFEN(t *testing.T) {
+	cases := []struct {
+		fen string
+		field string
+	}{
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP w KQkq - 0 1", "board"},
+		{"rnbqkbnr/pppppppp/9/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "board"},
+		{"rnbqkbnr/pppppppp/44/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "board"},
+		{"rnbqkbnr/ppppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "board"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQXBNR w KQkq - 0 1", "board"},
+		{"rnbq1bnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQ - 0 1", "board"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKKNR w kq - 0 1", "board"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNP w kq - 0 1", "board"},
+		{"4k3/8/8/8/8/8/PPPPPPPP/QQ2K3 w - - 0 1", "board"},
+		{"4k3/4Q3/8/8/8/8/8/4K3 w - - 0 1", "board"},
+		{goengine.START_FEN[:44] + " x KQkq - 0 1", "game turn"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkx - 0 1", "castling"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KKkq - 0 1", "castling"},
+		{"4k3/8/8/8/8/8/8/R3K3 w K - 0 1", "castling"},
+		{"4k3/8/8/8/8/8/8/R4K2 w Q - 0 1", "castling"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq e3 0 1", "En Passant"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq e6 0 1", "En Passant"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq e9 0 1", "En Passant"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - -1 1", "half move"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 0", "full move"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 70000", "full move"},
+		{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 x", "trailing"},
+	}
+
+	for _, c := range cases {
+		_, err := goengine.FromFEN(c.fen)
+		var fenErr *goengine.FENError
+		if !errors.As(err, &fenErr) {
+			t.Errorf("%s: expected FEN error, got: %v", c.fen, err)
+		} else if fenErr.Field != c.field {
+			t.Errorf("%s: expected %s error, got: %s", c.fen, c.field, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestNewGame(t *testing.T) {
+	game := goengine.NewGame()
+	if game.FEN() != goengine.START_FEN {
+		t.Errorf("Unexpected start position, got: %s", game.FEN())
+	}
+	if len(game.LegalMoves()) != 20 {
+		t.Errorf("Expected 20 legal moves, got: %d", len(game.LegalMoves()))
+	}
+	if game.SideToMove() != goengine.WHITE {
+		t.Errorf("Expected white to move")
+	}
+}
+
+func TestFromFEN(t *testing.T) {
+	_, err := goengine.FromFEN("8/8/8/8/8/8/8/8 x - - 0 1")
+	if !errors.Is(err, goengine.ErrInvalidFEN) {
+		t.Errorf("Expected invalid FEN error, got: %v", err)
+	}
+
+	_, err = goengine.FromFEN("8/8/8")
+	if !errors.Is(err, goengine.ErrInvalidFEN) {
+		t.Errorf("Expected invalid FEN error, got: %v", err)
+	}
+}
+
+func TestPushPop(t *testing.T) {
+	game := goengine.NewGame()
+	for _, san := range []string{"e4", "d5", "exd5", "Qxd5"} {
+		err := game.PushSAN(san)
+		if err != nil {
+			t.Fatalf("Failed to push %s: %s", san, err)
+		}
+	}
+
+	move, err := game.Pop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if move.Piece() != goengine.QUEEN || move.Captured() != goengine.PAWN ||
+	   move.From().String() != "d8" || move.To().String() != "d5" {
+		t.Errorf("Unexpected move popped: %s", move)
+	}
+
+	err = game.Push(move)
+	if err != nil {
+		t.Errorf("Failed to push popped move: %s", err)
+	}
+	if len(game.History()) != 4 {
+		t.Errorf("Expected 4 moves in history, got: %d", len(game.History()))
+	}
+
+	_, err = goengine.NewGame().Pop()
+	if err != goengine.ErrNoMoves {
+		t.Errorf("Expected no moves error, got: %v", err)
+	}
+}
+
+func TestPromotion(t *testing.T) {
+	game, err := goengine.FromFEN("1r2k3/P7/8/8/8/8/8/4K3 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var promotions int = 0
+	for _, move := range game.LegalMoves() {
+		if move.Promotion() != goengine.EMPTY {
+			promotions++
+		}
+	}
+	if promotions != 8 {
+		t.Errorf("Expected 8 promotions, got: %d", promotions)
+	}
+
+	err = game.PushUCI("a7b8q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.FEN() != "1Q2k3/8/8/8/8/8/8/4K3 b - - 0 1" {
+		t.Errorf("Unexpected position after promotion, got: %s", game.FEN())
+	}
+	if !game.InCheck() {
+		t.Errorf("Expected black to be in check")
+	}
+}
+
+func TestUndoRestoresPosition(t *testing.T) {
+	fen := "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 3 7"
+	game, err := goengine.FromFEN(fen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var played []goengine.Move
+	for _, cmd := range []string{"e1g1", "a6e2", "f3e2", "e8c8", "a2a4", "b4a3"} {
+		err = game.PushUCI(cmd)
+		if err != nil {
+			t.Fatalf("Failed to push %s: %s", cmd, err)
+		}
+		history := game.History()
+		played = append(played, history[len(history) - 1])
+	}
+
+	for i := len(played) - 1; i >= 0; i-- {
+		move, err := game.Pop()
+		if err != nil {
+			t.Fatal(err)
+		} else if move != played[i] {
+			t.Errorf("Expected %s to be popped, got: %s", played[i], move)
+		}
+	}
+	if game.FEN() != fen {
+		t.Errorf("Position not restored, got: %s", game.FEN())
+	}
+}
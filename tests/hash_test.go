@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func walkHash(t *testing.T, game *goengine.Game, depth int) {
+	if depth == 0 {
+		return
+	}
+
+	for _, move := range game.LegalMoves() {
+		var before uint64 = game.Hash()
+		game.Push(move)
+		if err := game.VerifyHash(); err != nil {
+			t.Fatalf("%s after %s: %s", game.FEN(), move, err)
+		}
+		walkHash(t, game, depth - 1)
+		game.Pop()
+		if game.Hash() != before {
+			t.Fatalf("Hash not restored after %s in %s", move, game.FEN())
+		}
+	}
+}
+
+func TestIncrementalHash(t *testing.T) {
+	fens := []string {
+		goengine.START_FEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+	}
+
+	for _, fen := range fens {
+		game, err := goengine.FromFEN(fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		walkHash(t, game, 3)
+	}
+}
+
+func TestHashTransposition(t *testing.T) {
+	first := goengine.NewGame()
+	for _, san := range []string{"Nf3", "Nf6", "Nc3", "Nc6"} {
+		first.PushSAN(san)
+	}
+
+	second := goengine.NewGame()
+	for _, san := range []string{"Nc3", "Nc6", "Nf3", "Nf6"} {
+		second.PushSAN(san)
+	}
+
+	if first.Hash() != second.Hash() {
+		t.Errorf("Transposed positions hash differently")
+	}
+
+	// Returning the knights restores the starting position
+	for _, san := range []string{"Ng1", "Ng8", "Nb1", "Nb8"} {
+		first.PushSAN(san)
+	}
+	if first.Hash() != goengine.NewGame().Hash() {
+		t.Errorf("Repeated start position hashes differently")
+	}
+}
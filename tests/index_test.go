@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestPositionIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var fileName string = filepath.Join(dir, "games.idx")
+	report, err := goengine.BuildIndex(context.Background(),
+		"files/pgn_stream.pgn.gz", fileName, &goengine.Pipeline{Workers: 2})
+	if err != nil || report.Games != 4 || len(report.Errors) != 1 {
+		t.Fatalf("Unexpected report: %+v (%v)", report, err)
+	}
+
+	index, err := goengine.OpenIndex(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+
+	// The malformed game is counted but has no positions
+	matches, err := index.Find(goengine.START_FEN)
+	if err != nil || len(matches) != 3 || index.Games() != 4 {
+		t.Fatalf("Expected 3 of 4 games from the start, got: %d (%v)",
+				 len(matches), err)
+	}
+	if matches[1].Game != 2 || matches[1].Offset != 1064 || matches[1].Ply != 0 {
+		t.Errorf("Unexpected match: %+v", matches[1])
+	}
+
+	var stats goengine.IndexStats = goengine.MatchStats(matches)
+	if stats.Games != 3 || stats.BlackWins != 1 || stats.Draws != 2 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+
+	// Clocks are not part of a position
+	matches, err = index.Find("8/8/7P/6k1/p3b3/8/1K6/8 w - - 50 100")
+	if err != nil || len(matches) != 1 || matches[0].Game != 2 ||
+	   matches[0].MoveNumber != 66 || matches[0].SideToMove != goengine.WHITE {
+		t.Errorf("Expected final position of game 2, got: %+v (%v)", matches, err)
+	}
+
+	matches, err = index.Find("8/8/8/8/8/8/8/k6K w - - 0 1")
+	if err != nil || len(matches) != 0 {
+		t.Errorf("Expected no matches, got: %+v (%v)", matches, err)
+	}
+
+	// Either side may hold the extra material
+	for _, signature := range []string{"KPPvKP", "P vs PP", "KBP v KP", "kp vs kbp"} {
+		matches, err = index.FindMaterial(signature)
+		if err != nil || len(matches) != 1 {
+			t.Errorf("%s: expected 1 game, got: %d (%v)", signature,
+					 len(matches), err)
+		}
+	}
+
+	_, err = index.FindMaterial("KRX v KR")
+	if err != goengine.ErrInvalidMaterial {
+		t.Errorf("Expected invalid material, got: %v", err)
+	}
+	_, err = goengine.OpenIndex("files/pgn_stream.pgn")
+	if err != goengine.ErrInvalidIndex {
+		t.Errorf("Expected invalid index, got: %v", err)
+	}
+}
+
+func TestPositionIndexUnordered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Odd games are won by white after 1. d4 d5, even ones by black after
+	// 1. e4 e5, and every tenth game has an illegal move
+	var builder strings.Builder
+	for i := 0; i < 200; i++ {
+		builder.WriteString("[Event \"Unordered\"]\n\n")
+		if i % 10 == 9 {
+			builder.WriteString("1. e4 Ke7 *\n\n")
+		} else if i % 2 == 1 {
+			builder.WriteString("1. d4 d5 2. c4 1-0\n\n")
+		} else {
+			builder.WriteString("1. e4 e5 2. Nf3 Nc6 3. Bb5 0-1\n\n")
+		}
+	}
+	var pgnName string = filepath.Join(dir, "games.pgn")
+	err = ioutil.WriteFile(pgnName, []byte(builder.String()), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fileName string = filepath.Join(dir, "games.idx")
+	report, err := goengine.BuildIndex(context.Background(), pgnName, fileName,
+		&goengine.Pipeline{Workers: 8, Unordered: true})
+	if err != nil || report.Games != 200 || len(report.Errors) != 20 {
+		t.Fatalf("Unexpected report: %+v (%v)", report, err)
+	}
+
+	index, err := goengine.OpenIndex(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+
+	matches, err := index.Find("rnbqkbnr/ppp1pppp/8/3p4/3P4/8/PPP1PPPP/RNBQKBNR w KQkq - 0 2")
+	if err != nil || len(matches) != 80 {
+		t.Fatalf("Expected 80 games after 1. d4 d5, got: %d (%v)", len(matches), err)
+	}
+	var last int64 = -1
+	for _, match := range matches {
+		if (match.Game % 2 != 1) || (match.Result != goengine.WHITE_WON) ||
+		   (match.Offset <= last) {
+			t.Errorf("Unexpected match: %+v", match)
+		}
+		last = match.Offset
+	}
+}
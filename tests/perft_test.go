@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"os"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+// Deeper counts are left to "gochess perft files/perftsuite.epd"
+const MAX_PERFT_NODES = 2000000
+
+func TestPerftSuite(t *testing.T) {
+	file, err := os.Open("files/perftsuite.epd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	positions, err := goengine.ReadEPD(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var limit uint64 = MAX_PERFT_NODES
+	if testing.Short() {
+		limit /= 20
+	}
+
+	for _, position := range positions {
+		game, err := goengine.FromFEN(position.FEN)
+		if err != nil {
+			t.Fatalf("%s: %s", position.FEN, err)
+		}
+
+		for depth, expected := range position.Nodes {
+			if expected > limit {
+				continue
+			}
+			nodes := game.Perft(depth)
+			if nodes != expected {
+				t.Errorf("%s at depth %d, got: %d, expected: %d",
+						 position.FEN, depth, nodes, expected)
+			}
+		}
+	}
+}
+
+func TestDivide(t *testing.T) {
+	game := goengine.NewGame()
+
+	var total uint64 = 0
+	var divide []goengine.PerftDivide = game.Divide(3)
+	for _, entry := range divide {
+		total += entry.Nodes
+	}
+	if len(divide) != 20 || total != 8902 {
+		t.Errorf("Expected 8902 nodes over 20 moves, got: %d over %d",
+				 total, len(divide))
+	}
+	if game.FEN() != goengine.START_FEN {
+		t.Errorf("Position not restored, got: %s", game.FEN())
+	}
+}
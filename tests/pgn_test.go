@@ -1,6 +1,9 @@
 package tests
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"github.com/hmccarty/gochess/goengine"
 )
@@ -13,10 +16,249 @@ func TestPGN(t *testing.T) {
 		"8/8/8/4k2p/5P2/8/5PK1/8 b - - 0 59",
 	}
 
-	var games []string = engine.PGNToFEN("files/pgn_data.pgn", 3)
+	games, err := engine.PGNToFEN("files/pgn_data.pgn", 3)
+	if err != nil || len(games) != 3 {
+		t.Fatalf("Failed to read PGN file, got %d games: %v", len(games), err)
+	}
 	for i, _ := range games {
 		if games[i] != fen[i] {
 			t.Errorf("Failed to parse PGN, got: %s, expected: %s", games[i], fen[i])
 		}
 	}
-}
\ No newline at end of file
+}
+
+const ANNOTATED_PGN = `[Event "Annotated"]
+[White "Smith, \"Kid\""]
+[Black "Doe"]
+[Result "1-0"]
+
+{Opening comment} 1. e4 e5 $1 2. Nf3 (2. f4 {King's Gambit} exf4 (2... d5))
+2... Nc6 3. Bb5!? a6 ; Morphy defence
+4. Ba4 1-0
+
+[Event "Promotion"]
+[SetUp "1"]
+[FEN "1r2k3/P7/8/8/8/8/8/4K3 w - - 0 1"]
+[Result "*"]
+
+1. axb8=Q+ Kd7 *
+`
+
+func TestParsePGN(t *testing.T) {
+	games, err := goengine.ParsePGN(strings.NewReader(ANNOTATED_PGN))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("Expected 2 games, got: %d", len(games))
+	}
+
+	pgn := games[0]
+	if pgn.Tag("White") != "Smith, \"Kid\"" || pgn.Result != "1-0" ||
+	   len(pgn.Tags) != 4 {
+		t.Errorf("Unexpected tags: %v", pgn.Tags)
+	}
+	if len(pgn.MainLine()) != 7 {
+		t.Errorf("Expected 7 moves in main line, got: %d", len(pgn.MainLine()))
+	}
+	if len(pgn.Root.Comments) != 1 || pgn.Root.Comments[0] != "Opening comment" {
+		t.Errorf("Unexpected game comment: %v", pgn.Root.Comments)
+	}
+
+	e5 := pgn.Root.Next().Next()
+	if len(e5.NAGs) != 1 || e5.NAGs[0] != 1 {
+		t.Errorf("Expected NAG 1 on e5, got: %v", e5.NAGs)
+	}
+	if len(e5.Children) != 2 || e5.Children[1].Move.String() != "f2f4" {
+		t.Fatalf("Expected f4 variation after e5")
+	}
+
+	f4 := e5.Children[1]
+	if len(f4.Comments) != 1 || f4.Comments[0] != "King's Gambit" {
+		t.Errorf("Unexpected comment on f4: %v", f4.Comments)
+	}
+	if len(f4.Children) != 2 || f4.Children[1].Move.String() != "d7d5" {
+		t.Errorf("Expected d5 variation after f4")
+	}
+
+	bb5 := e5.Next().Next().Next()
+	if bb5.Move.String() != "f1b5" || len(bb5.NAGs) != 1 || bb5.NAGs[0] != 5 {
+		t.Errorf("Expected Bb5 with NAG 5, got: %s %v", bb5.Move, bb5.NAGs)
+	}
+	if a6 := bb5.Next(); len(a6.Comments) != 1 || a6.Comments[0] != "Morphy defence" {
+		t.Errorf("Unexpected comment on a6: %v", a6.Comments)
+	}
+
+	game, err := games[1].Game()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.FEN() != "1Q6/3k4/8/8/8/8/8/4K3 w - - 1 2" {
+		t.Errorf("Unexpected position, got: %s", game.FEN())
+	}
+}
+
+func TestPGNErrors(t *testing.T) {
+	cases := []struct {
+		pgn string
+		line int
+		column int
+		err error
+	}{
+		{"[Event \"Bad\"]\n\n1. e4 e5 2. Ke3 *\n", 3, 13, goengine.ErrIllegalMove},
+		{"[Event \"Bad\n\n1. e4 *\n", 1, 8, goengine.ErrUnterminatedString},
+		{"1. e4 {never closed *\n", 1, 7, goengine.ErrUnterminatedComment},
+		{"1. e4 (1. d4 *\n", 1, 14, goengine.ErrUnterminatedVariation},
+		{"1. e4 e5 2. Nf3 & *\n", 1, 17, goengine.ErrUnexpectedCharacter},
+	}
+
+	for _, c := range cases {
+		_, err := goengine.ParsePGN(strings.NewReader(c.pgn))
+		var pgnErr *goengine.PGNError
+		if !errors.As(err, &pgnErr) || !errors.Is(err, c.err) ||
+		   !errors.Is(err, goengine.ErrInvalidPGN) {
+			t.Errorf("%q: expected %v, got: %v", c.pgn, c.err, err)
+		} else if pgnErr.Line != c.line || pgnErr.Column != c.column {
+			t.Errorf("%q: expected error at %d:%d, got: %s", c.pgn, c.line,
+					 c.column, err)
+		}
+	}
+
+	// Reading carries on with the game after a bad one
+	parser := goengine.NewPGNParser(strings.NewReader(
+		"1. e4 e5 2. Ke3 1-0\n\n1. d4 d5 *\n"))
+	if _, err := parser.Next(); err == nil {
+		t.Errorf("Expected illegal move error")
+	}
+	pgn, err := parser.Next()
+	if err != nil || len(pgn.MainLine()) != 2 {
+		t.Errorf("Expected second game to be read, got: %v", err)
+	}
+}
+
+func TestWritePGN(t *testing.T) {
+	games, err := goengine.ParsePGN(strings.NewReader(ANNOTATED_PGN))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected string = `[Event "Annotated"]
+[Site "?"]
+[Date "????.??.??"]
+[Round "?"]
+[White "Smith, \"Kid\""]
+[Black "Doe"]
+[Result "1-0"]
+[ECO "C70"]
+[Opening "Ruy Lopez: Morphy Defense"]
+
+{Opening comment} 1. e4 e5 $1 2. Nf3 (2. f4 {King's Gambit} 2... exf4 (2... d5)
+) 2... Nc6 3. Bb5 $5 a6 {Morphy defence} 4. Ba4 1-0
+
+`
+	if games[0].String() != expected {
+		t.Errorf("Unexpected PGN, got:\n%s", games[0].String())
+	}
+
+	// Writing what was read gives back the same game
+	for _, pgn := range games {
+		reread, err := goengine.ParsePGN(strings.NewReader(pgn.String()))
+		if err != nil || len(reread) != 1 || reread[0].String() != pgn.String() {
+			t.Errorf("PGN did not round trip: %v\n%s", err, pgn.String())
+		}
+	}
+}
+
+func TestGamePGN(t *testing.T) {
+	game, _ := goengine.FromFEN("1r2k3/P7/8/8/8/8/8/4K3 b - - 0 1")
+	game.PushSAN("Kd7")
+	game.PushSAN("axb8=Q")
+
+	var pgn string = game.PGN(goengine.PGNTag{Name: "White", Value: "Engine"},
+							  goengine.PGNTag{Name: "Annotator", Value: "Test"})
+	if !strings.Contains(pgn, "[White \"Engine\"]\n[Black \"?\"]\n[Result \"*\"]\n" +
+		"[Annotator \"Test\"]\n[SetUp \"1\"]\n" +
+		"[FEN \"1r2k3/P7/8/8/8/8/8/4K3 b - - 0 1\"]\n\n1... Kd7 2. axb8=Q *\n") {
+		t.Errorf("Unexpected PGN, got:\n%s", pgn)
+	}
+
+	// Long games wrap within 80 columns
+	game = goengine.NewGame()
+	for i := 0; i < 20; i++ {
+		for _, san := range []string{"Nf3", "Nf6", "Ng1", "Ng8"} {
+			game.PushSAN(san)
+		}
+	}
+	pgn = game.PGN()
+	if strings.Contains(pgn, "[FEN") {
+		t.Errorf("FEN tag written for the standard starting position")
+	}
+	for _, line := range strings.Split(pgn, "\n") {
+		if len(line) > 79 {
+			t.Errorf("Line longer than 79 characters: %q", line)
+		}
+	}
+	if !strings.HasSuffix(pgn, " 1/2-1/2\n\n") {
+		t.Errorf("Expected drawn result, got:\n%s", pgn)
+	}
+}
+
+func TestPGNStream(t *testing.T) {
+	var results []string = []string{"0-1", "", "1/2-1/2", "1/2-1/2"}
+	var offsets []int64
+
+	for _, ext := range []string{"", ".gz", ".bz2", ".zst"} {
+		parser, err := goengine.OpenPGN("files/pgn_stream.pgn" + ext, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var i int = 0
+		offsets = nil
+		for result := range parser.Games(context.Background()) {
+			if i >= len(results) {
+				t.Errorf("%s: too many games", ext)
+			} else if results[i] == "" {
+				if !errors.Is(result.Err, goengine.ErrIllegalMove) {
+					t.Errorf("%s: expected illegal move, got: %v", ext, result.Err)
+				}
+			} else if result.Err != nil {
+				t.Errorf("%s: %v", ext, result.Err)
+			} else if result.Game.Result != results[i] {
+				t.Errorf("%s: expected %s, got: %s", ext, results[i],
+						 result.Game.Result)
+			} else {
+				offsets = append(offsets, result.Game.Offset)
+			}
+			i++
+		}
+		parser.Close()
+
+		if i != len(results) {
+			t.Errorf("%s: expected %d games, got: %d", ext, len(results), i)
+		}
+	}
+
+	// Resuming from a game's offset starts with that game
+	for _, ext := range []string{"", ".gz"} {
+		parser, err := goengine.OpenPGN("files/pgn_stream.pgn" + ext, offsets[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		pgn, err := parser.Next()
+		if err != nil || pgn.Tag("Round") != "542.1.216" || pgn.Offset != offsets[1] {
+			t.Errorf("%s: failed to resume at offset %d: %v", ext, offsets[1], err)
+		}
+		parser.Close()
+	}
+
+	// Readers stop early when cancelled
+	parser, _ := goengine.OpenPGN("files/pgn_stream.pgn", 0)
+	defer parser.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	var games <-chan goengine.PGNResult = parser.Games(ctx)
+	<-games
+	cancel()
+	for range games {
+	}
+}
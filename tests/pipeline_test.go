@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+// Every tenth game has an illegal move
+func pipelineGames(count int) string {
+	var builder strings.Builder
+	for i := 0; i < count; i++ {
+		builder.WriteString("[Event \"Pipeline\"]\n\n1. e4 e5 2. Nf3 ")
+		if i % 10 == 9 {
+			builder.WriteString("Ke7 3. Ke3 *\n\n")
+		} else {
+			builder.WriteString("Nc6 *\n\n")
+		}
+	}
+	return builder.String()
+}
+
+func TestPipeline(t *testing.T) {
+	var pipeline *goengine.Pipeline = &goengine.Pipeline{
+		Workers : 4,
+		Work    : goengine.ReplayGame,
+	}
+
+	var next int = 0
+	report, err := pipeline.Run(context.Background(),
+		goengine.NewPGNParser(strings.NewReader(pipelineGames(200))),
+		func(result goengine.PipelineResult) error {
+			if result.Index != next {
+				t.Errorf("Expected game %d, got: %d", next, result.Index)
+			}
+			next++
+
+			if result.Index % 10 == 9 {
+				if !errors.Is(result.Err, goengine.ErrIllegalMove) {
+					t.Errorf("Game %d: expected illegal move, got: %v",
+							 result.Index, result.Err)
+				}
+			} else if result.Err != nil {
+				t.Errorf("Game %d: %v", result.Index, result.Err)
+			} else if len(result.Value.(*goengine.Game).History()) != 4 {
+				t.Errorf("Game %d was not replayed", result.Index)
+			}
+			return nil
+		})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Games != 200 || len(report.Errors) != 20 {
+		t.Errorf("Expected 200 games and 20 errors, got: %d and %d",
+				 report.Games, len(report.Errors))
+	}
+	if report.Errors[0].Index != 9 || report.Errors[0].Offset == 0 {
+		t.Errorf("Unexpected first error: %v", report.Errors[0])
+	}
+}
+
+func TestPipelineUnordered(t *testing.T) {
+	var pipeline *goengine.Pipeline = &goengine.Pipeline{
+		Workers   : 8,
+		Unordered : true,
+		Work      : goengine.ReplayGame,
+	}
+
+	var seen map[int]bool = make(map[int]bool)
+	var progress int = 0
+	pipeline.Progress = func(report goengine.PipelineReport) {
+		progress = report.Games
+	}
+	report, err := pipeline.Run(context.Background(),
+		goengine.NewPGNParser(strings.NewReader(pipelineGames(100))),
+		func(result goengine.PipelineResult) error {
+			seen[result.Index] = true
+			return nil
+		})
+
+	if err != nil || report.Games != 100 || len(seen) != 100 {
+		t.Errorf("Expected 100 distinct games, got: %d (%v)", len(seen), err)
+	}
+	if progress != 100 {
+		t.Errorf("Expected final progress report, got: %d games", progress)
+	}
+}
+
+func TestPipelineStop(t *testing.T) {
+	var pipeline *goengine.Pipeline = &goengine.Pipeline{Workers: 2}
+	var stop error = errors.New("Stop.")
+	report, err := pipeline.Run(context.Background(),
+		goengine.NewPGNParser(strings.NewReader(pipelineGames(100))),
+		func(result goengine.PipelineResult) error {
+			if result.Index == 4 {
+				return stop
+			}
+			return nil
+		})
+	if err != stop || report.Games != 5 {
+		t.Errorf("Expected to stop after 5 games, got: %d (%v)", report.Games, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pipeline.Run(ctx,
+		goengine.NewPGNParser(strings.NewReader(pipelineGames(100))), nil)
+	if err != context.Canceled {
+		t.Errorf("Expected cancellation, got: %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestSAN(t *testing.T) {
+	cases := []struct {
+		fen string
+		uci string
+		san string
+	}{
+		{goengine.START_FEN, "g1f3", "Nf3"},
+		{goengine.START_FEN, "e2e4", "e4"},
+		{"4k3/8/8/8/8/8/4K3/R6R w - - 0 1", "a1d1", "Rad1"},
+		{"4k3/8/8/R7/8/8/8/R3K3 w - - 0 1", "a1a3", "R1a3"},
+		{"4k3/8/8/8/8/Q7/8/Q1Q1K3 w - - 0 1", "a1c3", "Qa1c3"},
+		{"rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq d6 0 2", "e4d5", "exd5"},
+		{"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", "e5f6", "exf6"},
+		{"1r2k3/P7/8/8/8/8/8/4K3 w - - 0 1", "a7b8q", "axb8=Q+"},
+		{"1r2k3/P7/8/8/8/8/8/4K3 w - - 0 1", "a7a8n", "a8=N"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "e1g1", "O-O"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "e1c1", "O-O-O"},
+		{"5k2/8/8/8/8/8/8/4K2R w K - 0 1", "e1g1", "O-O+"},
+		{"rnbqkbnr/pppp1ppp/8/4p3/6P1/5P2/PPPPP2P/RNBQKBNR b KQkq - 0 2", "d8h4", "Qh4#"},
+	}
+
+	for _, c := range cases {
+		game, err := goengine.FromFEN(c.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found bool = false
+		for _, move := range game.LegalMoves() {
+			if move.String() != c.uci {
+				continue
+			}
+			found = true
+			if san := game.SAN(move); san != c.san {
+				t.Errorf("%s in %s, got: %s, expected: %s", c.uci, c.fen, san, c.san)
+			}
+		}
+		if !found {
+			t.Errorf("%s is not legal in %s", c.uci, c.fen)
+		}
+	}
+}
+
+func TestParseMove(t *testing.T) {
+	const castling = "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"
+	const promotion = "1r2k3/P7/8/8/8/8/8/4K3 w - - 0 1"
+	const rooks = "4k3/8/8/8/8/8/4K3/R6R w - - 0 1"
+	const capture = "4k3/8/8/3p4/4P3/8/8/4K3 w - - 0 1"
+	cases := []struct {
+		fen string
+		notation string
+		uci string
+		err error
+	}{
+		{goengine.START_FEN, "e4", "e2e4", nil},
+		{goengine.START_FEN, "e2-e4", "e2e4", nil},
+		{goengine.START_FEN, "e2e4", "e2e4", nil},
+		{goengine.START_FEN, "Nf3", "g1f3", nil},
+		{goengine.START_FEN, "Ng1-f3", "g1f3", nil},
+		{goengine.START_FEN, "Nf3!?", "g1f3", nil},
+		{goengine.START_FEN, "", "", goengine.ErrInvalidNotation},
+		{goengine.START_FEN, "N", "", goengine.ErrInvalidNotation},
+		{goengine.START_FEN, "e9", "", goengine.ErrInvalidNotation},
+		{goengine.START_FEN, "Nz3", "", goengine.ErrInvalidNotation},
+		{goengine.START_FEN, "e5", "", goengine.ErrIllegalMove},
+		{goengine.START_FEN, "Qh5", "", goengine.ErrIllegalMove},
+		{goengine.START_FEN, "e3e4", "", goengine.ErrNoPiece},
+		{goengine.START_FEN, "e7e5", "", goengine.ErrOpponentPiece},
+		{goengine.START_FEN, "O-O", "", goengine.ErrCannotCastle},
+		{goengine.START_FEN, "Nxf3", "", goengine.ErrCaptureMark},
+		{goengine.START_FEN, "e2xe4", "", goengine.ErrCaptureMark},
+		{capture, "exd5", "e4d5", nil},
+		{capture, "e4xd5", "e4d5", nil},
+		{capture, "e4d5", "e4d5", nil},
+		{capture, "d5", "", goengine.ErrCaptureMark},
+		{capture, "ed5", "", goengine.ErrCaptureMark},
+		{capture, "e4-d5", "", goengine.ErrCaptureMark},
+		{"4k3/8/8/8/8/8/8/4K3 w - - 0 1", "Qd4", "", goengine.ErrNoMatchingPiece},
+		{"4k3/4r3/8/8/8/8/4N3/4K3 w - - 0 1", "Nc3", "", goengine.ErrKingInCheck},
+		{rooks, "Rd1", "", goengine.ErrAmbiguousMove},
+		{rooks, "Rad1", "a1d1", nil},
+		{rooks, "Rh1-d1", "h1d1", nil},
+		{promotion, "axb8=N", "a7b8n", nil},
+		{promotion, "a8Q", "a7a8q", nil},
+		{promotion, "a7a8r", "a7a8r", nil},
+		{promotion, "a8", "", goengine.ErrInvalidPromotion},
+		{promotion, "a8=K", "", goengine.ErrInvalidPromotion},
+		{castling, "O-O", "e1g1", nil},
+		{castling, "0-0-0", "e1c1", nil},
+		{castling, "e1g1", "e1g1", nil},
+	}
+
+	for _, c := range cases {
+		game, err := goengine.FromFEN(c.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := game.ParseMove(c.notation)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%q in %s, got error: %v, expected: %v", c.notation, c.fen, err, c.err)
+		} else if err == nil && move.String() != c.uci {
+			t.Errorf("%q in %s, got: %s, expected: %s", c.notation, c.fen, move, c.uci)
+		}
+	}
+
+	_, err := goengine.NewGame().ParseMove("e5")
+	var moveErr *goengine.MoveError
+	if !errors.As(err, &moveErr) || moveErr.Notation != "e5" ||
+	   err.Error() != "Illegal move: \"e5\"." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
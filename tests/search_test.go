@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestSearchMate(t *testing.T) {
+	cases := []struct {
+		fen string
+		depth int
+		san string
+		mate int
+	}{
+		{"6k1/5ppp/8/8/8/8/8/R5K1 w - - 0 1", 2, "Ra8#", 1},
+		{"r1bqkb1r/pppp1ppp/2n2n2/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4",
+		 3, "Qxf7#", 1},
+		{"r5k1/5ppp/8/8/8/8/1R6/1R4K1 w - - 0 1", 4, "Rb8+", 2},
+		{"k7/8/1K6/8/8/8/8/7R b - - 0 1", 3, "Kb8", -1},
+	}
+
+	for _, c := range cases {
+		game, err := goengine.FromFEN(c.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var limits goengine.SearchLimits = goengine.SearchLimits{Depth: c.depth}
+		var result goengine.SearchResult = game.Search(context.Background(),
+													   limits, nil)
+		if result.Mate != c.mate {
+			t.Errorf("%s: expected mate in %d, got: %d (score %d)", c.fen,
+					 c.mate, result.Mate, result.Score)
+		}
+		if (c.san != "") && (game.SAN(result.Move) != c.san) {
+			t.Errorf("%s: expected %s, got: %s", c.fen, c.san,
+					 game.SAN(result.Move))
+		}
+	}
+}
+
+func TestSearchResult(t *testing.T) {
+	game, err := goengine.FromFEN("4k3/8/8/3q4/8/8/8/3RK3 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var depths []int
+	var result goengine.SearchResult = game.Search(context.Background(),
+		goengine.SearchLimits{Depth: 3}, func(result goengine.SearchResult) {
+			depths = append(depths, result.Depth)
+		})
+
+	if game.SAN(result.Move) != "Rxd5" || result.Score < 300 {
+		t.Errorf("Expected Rxd5 winning the queen, got: %s (score %d)",
+				 game.SAN(result.Move), result.Score)
+	}
+	if (result.Depth != 3) || (len(depths) != 3) || (depths[2] != 3) {
+		t.Errorf("Expected iterations to depth 3, got: %v", depths)
+	}
+	if (len(result.PV) == 0) || (result.PV[0] != result.Move) || (result.Nodes == 0) {
+		t.Errorf("Unexpected principal variation: %v, nodes %d", result.PV,
+				 result.Nodes)
+	}
+	if game.FEN() != "4k3/8/8/3q4/8/8/8/3RK3 w - - 0 1" {
+		t.Errorf("Search changed the game: %s", game.FEN())
+	}
+
+	// The principal variation is playable
+	for _, move := range result.PV {
+		err = game.Push(move)
+		if err != nil {
+			t.Errorf("Illegal move in principal variation: %v", move)
+		}
+	}
+}
+
+func TestSearchLimits(t *testing.T) {
+	var game *goengine.Game = goengine.NewGame()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100 * time.Millisecond)
+	defer cancel()
+	var start time.Time = time.Now()
+	var result goengine.SearchResult = game.Search(ctx,
+		goengine.SearchLimits{Infinite: true}, nil)
+	if time.Since(start) > time.Second {
+		t.Errorf("Cancelled search took %s", time.Since(start))
+	}
+	if (result.Move == goengine.NO_MOVE) || (result.Depth == 0) {
+		t.Errorf("Expected a move from a cancelled search, got: %+v", result)
+	}
+
+	start = time.Now()
+	result = game.Search(context.Background(), goengine.SearchLimits{
+		Time : [2]time.Duration{2 * time.Second, 2 * time.Second},
+		Inc  : [2]time.Duration{0, 0},
+	}, nil)
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected about a thirtieth of the clock, took %s",
+				 time.Since(start))
+	}
+
+	result = game.Search(context.Background(), goengine.SearchLimits{Nodes: 500}, nil)
+	if (result.Nodes > 600) || (result.Move == goengine.NO_MOVE) {
+		t.Errorf("Expected a move within 500 nodes, got: %+v", result)
+	}
+
+	// Nothing to search when the game is over
+	game, _ = goengine.FromFEN("7k/6Q1/6K1/8/8/8/8/8 b - - 0 1")
+	result = game.Search(context.Background(), goengine.SearchLimits{}, nil)
+	if result.Move != goengine.NO_MOVE {
+		t.Errorf("Expected no move when mated, got: %v", result.Move)
+	}
+}
+
+func TestSearchOptions(t *testing.T) {
+	game, err := goengine.FromFEN("r5k1/5ppp/8/8/8/8/1R6/1R4K1 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var all goengine.SearchOptions = goengine.DefaultSearchOptions()
+	var none goengine.SearchOptions
+	var variants = []goengine.SearchOptions{all, none}
+	for i := 0; i < 7; i++ {
+		var options goengine.SearchOptions = all
+		var switches = []*bool{&options.PVS, &options.NullMove, &options.LMR,
+							   &options.Futility, &options.ReverseFutility,
+							   &options.CheckExtensions, &options.AspirationWindows}
+		*switches[i] = false
+		variants = append(variants, options)
+	}
+
+	// Each technique can be left out without missing the mate
+	for _, options := range variants {
+		var result goengine.SearchResult = game.SearchWithOptions(
+			context.Background(), goengine.SearchLimits{Depth: 5}, options, nil)
+		if (result.Mate != 2) || (game.SAN(result.Move) != "Rb8+") {
+			t.Errorf("%+v: expected Rb8+ mating in 2, got: %s (mate %d)",
+					 options, game.SAN(result.Move), result.Mate)
+		}
+	}
+
+	// Together they prune the tree
+	game, _ = goengine.FromFEN(
+		"r1bq1rk1/pp2bppp/2n1pn2/3p4/2PP4/2N1PN2/PP2BPPP/R2QKB1R w KQ - 0 8")
+	var limits goengine.SearchLimits = goengine.SearchLimits{Depth: 5}
+	var pruned goengine.SearchResult = game.SearchWithOptions(
+		context.Background(), limits, all, nil)
+	var full goengine.SearchResult = game.SearchWithOptions(
+		context.Background(), limits, none, nil)
+	if pruned.Nodes >= full.Nodes {
+		t.Errorf("Expected fewer nodes with pruning, got: %d and %d without",
+				 pruned.Nodes, full.Nodes)
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestSEE(t *testing.T) {
+	cases := []struct {
+		fen string
+		san string
+		gain int
+	}{
+		{"1k1r4/1pp4p/p7/4p3/8/P5P1/1PP4P/2K1R3 w - - 0 1", "Rxe5", 100},
+		{"1k1r3q/1ppn3p/p4b2/4p3/8/P2N2P1/1PP1R1BP/2K1Q3 w - - 0 1", "Nxe5", -220},
+		{"4k3/8/2p5/3p4/8/8/8/3QK3 w - - 0 1", "Qxd5", -800},
+		{"4k3/8/8/3r4/8/3R4/3R4/4K3 w - - 0 1", "Rxd5", 500},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=Q", 800},
+		{"4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", "exd6", 100},
+		// The king may not recapture onto a defended square
+		{"8/8/8/8/2k5/3p4/4Q3/5B1K w - - 0 1", "Qxd3+", 100},
+	}
+
+	for _, c := range cases {
+		game, err := goengine.FromFEN(c.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		move, err := game.ParseMove(c.san)
+		if err != nil {
+			t.Fatalf("%s: %v", c.san, err)
+		}
+
+		if gain := game.SEE(move); gain != c.gain {
+			t.Errorf("%s %s: expected %d, got: %d", c.fen, c.san, c.gain, gain)
+		}
+	}
+}
+
+func TestHangingPieces(t *testing.T) {
+	game, err := goengine.FromFEN("4k3/8/2p5/3n4/4P3/5N2/8/4K2R w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The knight is defended, but by a pawn worth less than it
+	var hanging []goengine.Square = game.HangingPieces(goengine.BLACK)
+	if (len(hanging) != 1) || (hanging[0].String() != "d5") {
+		t.Errorf("Expected d5 hanging, got: %v", hanging)
+	}
+	if hanging = game.HangingPieces(goengine.WHITE); len(hanging) != 0 {
+		t.Errorf("Expected no white pieces hanging, got: %v", hanging)
+	}
+}
+
+func TestQuiescence(t *testing.T) {
+	// Taking the pawn loses the queen to the recapture
+	game, err := goengine.FromFEN("4k3/8/2p5/3p4/8/8/8/3QK3 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result goengine.SearchResult = game.Search(context.Background(),
+		goengine.SearchLimits{Depth: 1}, nil)
+	if game.SAN(result.Move) == "Qxd5" {
+		t.Errorf("Expected the search to see the recapture, got: %s (score %d)",
+				 game.SAN(result.Move), result.Score)
+	}
+}
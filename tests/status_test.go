@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestGameStatus(t *testing.T) {
+	cases := []struct {
+		fen string
+		status goengine.GameStatus
+		termination goengine.Termination
+	}{
+		{goengine.START_FEN, goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"7k/5Q2/6K1/8/8/8/8/8 b - - 0 1", goengine.DRAW, goengine.STALEMATE},
+		{"7k/6Q1/6K1/8/8/8/8/8 b - - 0 1", goengine.WHITE_WON, goengine.CHECKMATE},
+		{"8/8/8/8/8/5k2/8/q5K1 w - - 0 1", goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"8/8/8/4k3/8/8/8/4K3 w - - 0 1", goengine.DRAW, goengine.INSUFFICIENT_MATERIAL},
+		{"8/8/8/4k3/8/8/8/4KB2 w - - 0 1", goengine.DRAW, goengine.INSUFFICIENT_MATERIAL},
+		{"8/8/8/4k3/8/8/8/4KN2 w - - 0 1", goengine.DRAW, goengine.INSUFFICIENT_MATERIAL},
+		{"8/8/8/4kb2/8/8/8/4KB2 w - - 0 1", goengine.DRAW, goengine.INSUFFICIENT_MATERIAL},
+		{"8/8/8/4k1b1/8/8/8/4KB2 w - - 0 1", goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"8/8/8/4k3/8/8/8/3NKN2 w - - 0 1", goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"8/8/8/4k3/8/8/4P3/4K3 w - - 99 80", goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"8/8/8/4k3/8/8/4P3/4K3 w - - 100 80", goengine.IN_PLAY, goengine.NO_TERMINATION},
+		{"8/8/8/4k3/8/8/4P3/4K3 w - - 150 105", goengine.DRAW, goengine.SEVENTY_FIVE_MOVE_RULE},
+	}
+
+	for _, c := range cases {
+		game, err := goengine.FromFEN(c.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if game.Status() != c.status || game.Termination() != c.termination {
+			t.Errorf("%s: got %s (%s), expected %s (%s)", c.fen,
+					 game.Status(), game.Termination(), c.status, c.termination)
+		}
+	}
+}
+
+func TestRepetition(t *testing.T) {
+	game := goengine.NewGame()
+	var dance []string = []string{"Nf3", "Nf6", "Ng1", "Ng8"}
+
+	// Start position occurs for the third time after two knight dances,
+	// which lets a draw be claimed but does not end the game
+	for i := 0; i < 2; i++ {
+		for _, san := range dance {
+			if game.CanClaimDraw() {
+				t.Fatalf("Draw claimable early by %s", game.ClaimableDraw())
+			}
+			game.PushSAN(san)
+		}
+	}
+	if game.Status() != goengine.IN_PLAY ||
+	   game.ClaimableDraw() != goengine.THREEFOLD_REPETITION {
+		t.Errorf("Expected threefold repetition to be claimable, got: %s (%s)",
+				 game.Status(), game.ClaimableDraw())
+	}
+
+	for i := 0; i < 2; i++ {
+		for _, san := range dance {
+			game.PushSAN(san)
+		}
+	}
+	if game.Termination() != goengine.FIVEFOLD_REPETITION || game.CanClaimDraw() {
+		t.Errorf("Expected fivefold repetition, got: %s", game.Termination())
+	}
+
+	game.Pop()
+	if game.Status() != goengine.IN_PLAY ||
+	   game.ClaimableDraw() != goengine.THREEFOLD_REPETITION {
+		t.Errorf("Expected a claimable draw after taking back, got: %s (%s)",
+				 game.Status(), game.ClaimableDraw())
+	}
+	if err := game.ClaimDraw(); err != nil ||
+	   game.Termination() != goengine.THREEFOLD_REPETITION {
+		t.Errorf("Expected draw by threefold repetition, got: %s (%v)",
+				 game.Termination(), err)
+	}
+}
+
+func TestFiftyMoveClaim(t *testing.T) {
+	game, _ := goengine.FromFEN("8/8/8/4k3/8/8/4P3/4K3 w - - 99 80")
+	if err := game.ClaimDraw(); err != goengine.ErrNoDrawClaim {
+		t.Errorf("Expected no draw to claim, got: %v", err)
+	}
+
+	game.PushSAN("Kd1")
+	if err := game.ClaimDraw(); err != nil ||
+	   game.Status() != goengine.DRAW ||
+	   game.Termination() != goengine.FIFTY_MOVE_RULE {
+		t.Errorf("Expected draw by the fifty-move rule, got: %s (%v)",
+				 game.Termination(), err)
+	}
+}
+
+func TestAdjudication(t *testing.T) {
+	game := goengine.NewGame()
+	game.Resign(goengine.WHITE)
+	if game.Status() != goengine.BLACK_WON || game.Termination() != goengine.RESIGNATION {
+		t.Errorf("Expected black to win by resignation")
+	}
+
+	game, _ = goengine.FromFEN("8/8/8/4k3/8/8/8/3QK3 b - - 0 1")
+	game.ForfeitOnTime(goengine.BLACK)
+	if game.Status() != goengine.WHITE_WON || game.Termination() != goengine.TIME_FORFEIT {
+		t.Errorf("Expected white to win on time")
+	}
+
+	game, _ = goengine.FromFEN("8/8/8/4k3/8/8/8/3QK3 b - - 0 1")
+	game.ForfeitOnTime(goengine.WHITE)
+	if game.Status() != goengine.DRAW ||
+	   game.Termination() != goengine.TIMEOUT_VS_INSUFFICIENT_MATERIAL {
+		t.Errorf("Expected draw on time against a lone king")
+	}
+}
+
+func TestPGNTermination(t *testing.T) {
+	cases := []struct {
+		pgn string
+		status goengine.GameStatus
+		termination goengine.Termination
+	}{
+		{"1. f3 e5 2. g4 Qh4# 0-1", goengine.BLACK_WON, goengine.CHECKMATE},
+		// The tag is only used when the position does not decide the result
+		{"[Termination \"time forfeit\"]\n\n1. f3 e5 2. g4 Qh4# 0-1",
+		 goengine.BLACK_WON, goengine.CHECKMATE},
+		{"[Termination \"time forfeit\"]\n\n1. e4 e5 0-1",
+		 goengine.BLACK_WON, goengine.TIME_FORFEIT},
+		{"[Termination \"adjudication\"]\n\n1. e4 e5 1/2-1/2",
+		 goengine.DRAW, goengine.ADJUDICATION},
+		{"1. e4 e5 1-0", goengine.WHITE_WON, goengine.RESIGNATION},
+		{"[Termination \"Normal\"]\n\n1. e4 e5 1/2-1/2",
+		 goengine.DRAW, goengine.DRAW_AGREED},
+		{"1. Nf3 Nf6 2. Ng1 Ng8 3. Nf3 Nf6 4. Ng1 Ng8 1/2-1/2",
+		 goengine.DRAW, goengine.THREEFOLD_REPETITION},
+		{"1. e4 e5 *", goengine.IN_PLAY, goengine.NO_TERMINATION},
+	}
+
+	for _, c := range cases {
+		pgn, err := goengine.NewPGNParser(strings.NewReader(c.pgn)).Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		game, err := pgn.Game()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if game.Status() != c.status || game.Termination() != c.termination {
+			t.Errorf("%q: got %s (%s), expected %s (%s)", c.pgn, game.Status(),
+					 game.Termination(), c.status, c.termination)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+const TRAINING_PGN = `[Event "Rated Blitz game"]
+[WhiteElo "2100"]
+[BlackElo "1950"]
+[TimeControl "180+2"]
+[Result "1-0"]
+
+1. e4 { [%eval 0.2] } 1... d5 { [%eval 0.35] } 2. e5 { [%eval 0.1] }
+2... f5 { [%eval 1.5] } 3. exf6 { [%eval #-4] } 3... Nc6 4. fxg7 Nf6
+5. gxh8=Q 1-0
+
+[Event "Rated Bullet game"]
+[WhiteElo "2500"]
+[BlackElo "2400"]
+[TimeControl "60+0"]
+[Result "0-1"]
+
+1. f3 e5 2. g4 Qh4# 0-1
+
+[Event "Unfinished"]
+[WhiteElo "2500"]
+[BlackElo "2400"]
+[TimeControl "600+0"]
+
+1. d4 *
+`
+
+func TestTrainingSamples(t *testing.T) {
+	games, err := goengine.ParsePGN(strings.NewReader(TRAINING_PGN))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	samples, err := goengine.TrainingSamples(games[0], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) != 7 {
+		t.Fatalf("Expected 7 samples, got: %d", len(samples))
+	}
+
+	var first goengine.TrainingSample = samples[0]
+	if first.Ply != 2 || first.Move != "e4e5" || first.SideToMove != "w" ||
+	   first.Result != "1-0" || first.WhiteElo != 2100 || first.BlackElo != 1950 {
+		t.Errorf("Unexpected sample: %+v", first)
+	}
+	if first.Eval == nil || *first.Eval != 35 || first.Mate != nil {
+		t.Errorf("Expected eval of 35, got: %v", first.Eval)
+	}
+	if samples[1].FEN != "rnbqkbnr/ppp1pppp/8/3pP3/8/8/PPPP1PPP/RNBQKBNR b KQkq - 0 2" {
+		t.Errorf("Unexpected FEN, got: %s", samples[1].FEN)
+	}
+	if samples[3].Mate == nil || *samples[3].Mate != -4 || samples[3].Eval != nil {
+		t.Errorf("Expected mate in -4, got: %v", samples[3].Mate)
+	}
+	if samples[4].Eval != nil || samples[4].Mate != nil {
+		t.Errorf("Expected no eval after exf6")
+	}
+
+	var filter goengine.TrainingFilter = goengine.TrainingFilter{MinElo: 2000}
+	if filter.Accepts(games[0]) || !filter.Accepts(games[1]) || filter.Accepts(games[2]) {
+		t.Errorf("Rating filter accepted the wrong games")
+	}
+	filter = goengine.TrainingFilter{Speeds: []string{"blitz", "rapid"}}
+	if !filter.Accepts(games[0]) || filter.Accepts(games[1]) {
+		t.Errorf("Speed filter accepted the wrong games")
+	}
+}
+
+func TestTimeControlSpeed(t *testing.T) {
+	var speeds map[string]string = map[string]string{
+		"15+0"   : "ultrabullet",
+		"60+1"   : "bullet",
+		"180+2"  : "blitz",
+		"600+5"  : "rapid",
+		"1800+0" : "classical",
+		"-"      : "correspondence",
+		"?"      : "",
+	}
+	for timeControl, speed := range speeds {
+		if goengine.TimeControlSpeed(timeControl) != speed {
+			t.Errorf("%s: expected %q, got: %q", timeControl, speed,
+					 goengine.TimeControlSpeed(timeControl))
+		}
+	}
+}
+
+func TestPackedBoard(t *testing.T) {
+	var fens []string = []string{
+		goengine.START_FEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 b - - 12 1",
+		"4k3/8/8/8/8/8/8/4K2R b K - 0 1",
+	}
+
+	for _, fen := range fens {
+		game, _ := goengine.FromFEN(fen)
+		unpacked, err := game.Pack().Game()
+		if err != nil {
+			t.Errorf("%s: %v", fen, err)
+			continue
+		}
+
+		// Full move numbers are not packed
+		var expected string = fen[:strings.LastIndex(fen, " ")]
+		var got string = unpacked.FEN()
+		if got[:strings.LastIndex(got, " ")] != expected {
+			t.Errorf("Expected %s, got: %s", expected, got)
+		}
+	}
+}
+
+func TestExportTrainingData(t *testing.T) {
+	var pipeline *goengine.Pipeline = &goengine.Pipeline{Workers: 2}
+
+	var jsonl bytes.Buffer
+	_, count, err := goengine.ExportTrainingData(context.Background(),
+		goengine.NewPGNParser(strings.NewReader(TRAINING_PGN)), &jsonl,
+		goengine.JSONL_FORMAT, goengine.TrainingFilter{}, pipeline)
+	if err != nil || count != 13 {
+		t.Fatalf("Expected 13 samples, got: %d (%v)", count, err)
+	}
+
+	var expected []goengine.TrainingSample
+	for _, line := range strings.Split(strings.TrimSpace(jsonl.String()), "\n") {
+		var sample goengine.TrainingSample
+		err = json.Unmarshal([]byte(line), &sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, sample)
+	}
+
+	var binary bytes.Buffer
+	_, count, err = goengine.ExportTrainingData(context.Background(),
+		goengine.NewPGNParser(strings.NewReader(TRAINING_PGN)), &binary,
+		goengine.BINARY_FORMAT, goengine.TrainingFilter{}, pipeline)
+	if err != nil || binary.Len() != count * goengine.TRAINING_SAMPLE_SIZE {
+		t.Fatalf("Unexpected binary output: %d bytes (%v)", binary.Len(), err)
+	}
+
+	for i := 0; ; i++ {
+		sample, err := goengine.ReadTrainingSample(&binary)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+
+		// Full move numbers are not packed
+		var want goengine.TrainingSample = expected[i]
+		want.FEN = want.FEN[:strings.LastIndex(want.FEN, " ")]
+		sample.FEN = sample.FEN[:strings.LastIndex(sample.FEN, " ")]
+		sample.Board = want.Board
+		wantJSON, _ := json.Marshal(want)
+		gotJSON, _ := json.Marshal(sample)
+		if !bytes.Equal(wantJSON, gotJSON) {
+			t.Errorf("Sample %d: expected %s, got: %s", i, wantJSON, gotJSON)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+func TestTranspositionTable(t *testing.T) {
+	var table *goengine.TranspositionTable = goengine.NewTranspositionTable(4)
+	if (table.Size() != 4) || (table.Hashfull() != 0) {
+		t.Errorf("Expected an empty 4 MB table, got: %d MB, %d permille",
+				 table.Size(), table.Hashfull())
+	}
+
+	table.Resize(0)
+	if table.Size() != goengine.MIN_HASH_MB {
+		t.Errorf("Expected table to be clamped to %d MB, got: %d",
+				 goengine.MIN_HASH_MB, table.Size())
+	}
+}
+
+func TestSearchTable(t *testing.T) {
+	var table *goengine.TranspositionTable = goengine.NewTranspositionTable(2)
+	var options goengine.SearchOptions = goengine.DefaultSearchOptions()
+	options.Table = table
+
+	var game *goengine.Game = goengine.NewGame()
+	var limits goengine.SearchLimits = goengine.SearchLimits{Depth: 6}
+	var first goengine.SearchResult = game.SearchWithOptions(
+		context.Background(), limits, options, nil)
+	if table.Hashfull() == 0 {
+		t.Fatalf("Expected the search to fill the table")
+	}
+
+	// The second search starts from what the first one stored
+	var second goengine.SearchResult = game.SearchWithOptions(
+		context.Background(), limits, options, nil)
+	if second.Nodes >= first.Nodes {
+		t.Errorf("Expected fewer nodes with a shared table, got: %d after %d",
+				 second.Nodes, first.Nodes)
+	}
+}
+
+func TestSearchTablePV(t *testing.T) {
+	var options goengine.SearchOptions = goengine.DefaultSearchOptions()
+	options.Table = goengine.NewTranspositionTable(4)
+	var fens = []string{
+		goengine.START_FEN,
+		"r1bq1rk1/pp2bppp/2n1pn2/3p4/2PP4/2N1PN2/PP2BPPP/R2QKB1R w KQ - 0 8",
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+	}
+
+	// Searching again from what the table holds still gives a full line
+	for _, fen := range fens {
+		game, _ := goengine.FromFEN(fen)
+		for i := 0; i < 3; i++ {
+			var result goengine.SearchResult = game.SearchWithOptions(
+				context.Background(), goengine.SearchLimits{Depth: 6}, options, nil)
+			if len(result.PV) < result.Depth - 1 {
+				t.Errorf("%s: expected a principal variation of about %d moves, got: %v",
+						 fen, result.Depth, result.PV)
+			}
+		}
+	}
+}
+
+func TestClearTable(t *testing.T) {
+	var options goengine.SearchOptions = goengine.DefaultSearchOptions()
+	options.Table = goengine.NewTranspositionTable(1)
+	goengine.NewGame().SearchWithOptions(context.Background(),
+		goengine.SearchLimits{Depth: 6}, options, nil)
+	if options.Table.Hashfull() == 0 {
+		t.Fatalf("Expected the search to fill the table")
+	}
+
+	options.Table.Clear()
+	if options.Table.Hashfull() != 0 {
+		t.Errorf("Expected an empty table after clearing, got: %d permille",
+				 options.Table.Hashfull())
+	}
+}
+
+func TestUCIHash(t *testing.T) {
+	var engine goengine.GoEngine
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	go func() {
+		engine.RunUCI(inReader, outWriter)
+		outWriter.Close()
+	}()
+
+	var lines *bufio.Scanner = bufio.NewScanner(outReader)
+	var readUntil func(prefix string) []string = func(prefix string) []string {
+		var read []string
+		for lines.Scan() {
+			read = append(read, lines.Text())
+			if strings.HasPrefix(lines.Text(), prefix) {
+				return read
+			}
+		}
+		t.Fatalf("Expected %q, got: %v", prefix, read)
+		return nil
+	}
+
+	io.WriteString(inWriter, "uci\n")
+	var found bool = false
+	for _, line := range readUntil("uciok") {
+		found = found || strings.HasPrefix(line, "option name Hash type spin")
+	}
+	if !found {
+		t.Errorf("Expected the Hash option to be advertised")
+	}
+
+	io.WriteString(inWriter, "setoption name Hash value 2\n")
+	io.WriteString(inWriter, "position startpos moves e2e4 e7e5\n")
+	io.WriteString(inWriter, "go depth 5\n")
+	var info []string = readUntil("bestmove")
+	if (len(info) < 2) || !strings.Contains(info[len(info) - 2], "hashfull ") ||
+	   strings.Contains(info[len(info) - 2], "hashfull 0 ") {
+		t.Errorf("Expected hashfull to be reported, got: %v", info)
+	}
+
+	io.WriteString(inWriter, "quit\n")
+	inWriter.Close()
+}
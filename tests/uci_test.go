@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+// Runs the engine in UCI mode over pipes, returning functions to send it
+// a command and to read its output up to a line starting with prefix
+func startUCI(t *testing.T) (func(string), func(string) []string, chan error) {
+	var engine goengine.GoEngine
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	var done chan error = make(chan error, 1)
+	go func() {
+		done <- engine.RunUCI(inReader, outWriter)
+		outWriter.Close()
+	}()
+
+	// Commands are queued so sending never waits on unread output
+	var commands chan string = make(chan string, 64)
+	go func() {
+		for cmd := range commands {
+			io.WriteString(inWriter, cmd + "\n")
+		}
+	}()
+	var send func(cmd string) = func(cmd string) {
+		commands <- cmd
+	}
+	var lines *bufio.Scanner = bufio.NewScanner(outReader)
+	var readUntil func(prefix string) []string = func(prefix string) []string {
+		var read []string
+		for lines.Scan() {
+			read = append(read, lines.Text())
+			if strings.HasPrefix(lines.Text(), prefix) {
+				return read
+			}
+		}
+		t.Fatalf("Expected %q, got: %v", prefix, read)
+		return nil
+	}
+	return send, readUntil, done
+}
+
+func TestUCI(t *testing.T) {
+	send, readUntil, done := startUCI(t)
+
+	send("uci")
+	var lines []string = readUntil("uciok")
+	if !strings.HasPrefix(lines[0], "id name ") {
+		t.Errorf("Expected the engine's name first, got: %v", lines)
+	}
+	send("isready")
+	readUntil("readyok")
+
+	// Black is mated, so there is no move to make
+	send("position startpos moves f2f3 e7e5 g2g4 d8h4")
+	send("go depth 2")
+	lines = readUntil("bestmove")
+	if lines[len(lines) - 1] != "bestmove 0000" {
+		t.Errorf("Expected no move when mated, got: %v", lines)
+	}
+
+	// Taking the checking queen is the only legal move
+	send("position fen k7/8/8/8/8/8/2q5/K7 b - - 0 1 moves c2b2")
+	send("go depth 3")
+	lines = readUntil("bestmove")
+	if lines[len(lines) - 1] != "bestmove a1b2" {
+		t.Errorf("Expected a1b2, got: %v", lines)
+	}
+
+	send("position startpos moves e2e4 e7e4")
+	lines = readUntil("info string")
+	if !strings.Contains(lines[len(lines) - 1], "e7e4") {
+		t.Errorf("Expected the illegal move to be reported, got: %v", lines)
+	}
+
+	send("position startpos")
+	send("go depth 2")
+	lines = readUntil("bestmove")
+	if (len(lines) < 2) || !strings.HasPrefix(lines[0], "info depth ") {
+		t.Errorf("Expected search info before the move, got: %v", lines)
+	}
+
+	// Limits without a usable value are rejected rather than searched
+	for _, cmd := range []string{"go depth", "go depth x", "go depth 0",
+								 "go nodes -5 depth 2", "go wtime"} {
+		send(cmd)
+		send("isready")
+		lines = readUntil("readyok")
+		if (len(lines) != 2) || !strings.HasPrefix(lines[0], "info string Invalid") {
+			t.Errorf("%s: expected an error and no search, got: %v", cmd, lines)
+		}
+	}
+	send("go wtime 0 btime 0 depth 1")
+	readUntil("bestmove")
+
+	send("quit")
+	if err := <-done; err != nil {
+		t.Errorf("Expected a clean exit, got: %v", err)
+	}
+}
+
+func TestUCIInfinite(t *testing.T) {
+	send, readUntil, done := startUCI(t)
+
+	// An infinite search only moves once told to stop
+	send("position startpos")
+	send("go infinite")
+	send("isready")
+	readUntil("readyok")
+	send("stop")
+	var lines []string = readUntil("bestmove")
+	if lines[len(lines) - 1] == "bestmove 0000" {
+		t.Errorf("Expected a move after stopping, got: %v", lines)
+	}
+
+	// A ponder search is held until the opponent plays the expected move,
+	// then runs on the clock
+	send("position startpos moves e2e4")
+	send("go ponder wtime 200 btime 200")
+	send("isready")
+	lines = readUntil("readyok")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "bestmove") {
+			t.Errorf("Expected no move while pondering, got: %v", lines)
+		}
+	}
+	send("ponderhit")
+	lines = readUntil("bestmove")
+	if lines[len(lines) - 1] == "bestmove 0000" {
+		t.Errorf("Expected a move after ponderhit, got: %v", lines)
+	}
+
+	send("quit")
+	<-done
+}
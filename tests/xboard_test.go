@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"github.com/hmccarty/gochess/goengine"
+)
+
+// Runs the engine in xboard mode over pipes, returning functions to send
+// it a command and to read its output up to a line containing text
+func startXboard(t *testing.T) (func(string), func(string) []string, chan error) {
+	var engine goengine.GoEngine
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	var done chan error = make(chan error, 1)
+	go func() {
+		done <- engine.RunXboard(inReader, outWriter)
+		outWriter.Close()
+	}()
+
+	// Commands are queued so sending never waits on unread output
+	var commands chan string = make(chan string, 64)
+	go func() {
+		for cmd := range commands {
+			io.WriteString(inWriter, cmd + "\n")
+		}
+	}()
+	var send func(cmd string) = func(cmd string) {
+		commands <- cmd
+	}
+	var lines *bufio.Scanner = bufio.NewScanner(outReader)
+	var readUntil func(text string) []string = func(text string) []string {
+		var read []string
+		for lines.Scan() {
+			read = append(read, lines.Text())
+			if strings.Contains(lines.Text(), text) {
+				return read
+			}
+		}
+		t.Fatalf("Expected %q, got: %v", text, read)
+		return nil
+	}
+	return send, readUntil, done
+}
+
+func TestXboard(t *testing.T) {
+	send, readUntil, done := startXboard(t)
+
+	send("xboard")
+	send("protover 2")
+	var lines []string = readUntil("done=1")
+	if !strings.Contains(strings.Join(lines, " "), "usermove=1") {
+		t.Errorf("Expected usermove feature, got: %v", lines)
+	}
+	send("ping 1")
+	readUntil("pong 1")
+
+	// The engine plays black by default and answers the user's move
+	send("new")
+	send("sd 2")
+	send("usermove e2e4")
+	lines = readUntil("move ")
+	if !strings.HasPrefix(lines[len(lines) - 1], "move ") {
+		t.Errorf("Expected a reply to e2e4, got: %v", lines)
+	}
+
+	send("usermove e2e5")
+	readUntil("Illegal move: e2e5")
+
+	// Taking back both moves lets the game be replayed to a different end
+	send("force")
+	send("new")
+	send("force")
+	for _, move := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		send("usermove " + move)
+	}
+	readUntil("0-1 {Black mates}")
+	send("remove")
+	send("usermove g2g4")
+	send("usermove d8h4")
+	send("ping 2")
+	lines = readUntil("pong 2")
+	if (len(lines) != 2) || (lines[0] != "0-1 {Black mates}") {
+		t.Errorf("Expected mate again after taking back, got: %v", lines)
+	}
+
+	send("quit")
+	if err := <-done; err != nil {
+		t.Errorf("Expected a clean exit, got: %v", err)
+	}
+}
+
+func TestXboardSideToMove(t *testing.T) {
+	send, readUntil, done := startXboard(t)
+
+	// Protocol version 1 GUIs give black the move before asking for one
+	send("xboard")
+	send("new")
+	send("force")
+	send("black")
+	send("sd 2")
+	send("go")
+	var lines []string = readUntil("move ")
+	var move string = strings.TrimPrefix(lines[len(lines) - 1], "move ")
+
+	game, _ := goengine.FromFEN(
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR b KQkq - 0 1")
+	if err := game.PushUCI(move); err != nil {
+		t.Errorf("Expected a move for black, got: %q (%v)", move, err)
+	}
+
+	// Moves played before the side changed can still be taken back
+	send("force")
+	send("new")
+	send("force")
+	send("usermove e2e4")
+	send("white")
+	send("force")
+	send("usermove d2d4")
+	send("undo")
+	send("undo")
+	send("usermove e2e4")
+	send("ping 1")
+	lines = readUntil("pong 1")
+	if len(lines) != 1 {
+		t.Errorf("Expected the game to be taken back to the start, got: %v", lines)
+	}
+
+	send("quit")
+	<-done
+}